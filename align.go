@@ -1,5 +1,11 @@
 package table
 
+import (
+	"strings"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
 type Alignment uint8
 
 const (
@@ -8,4 +14,78 @@ const (
 	AlignCenter
 	AlignBottom
 	AlignTop
+	// AlignDecimal lines up the content of a column on its decimal separator (see SetDecimalSeparators), with the
+	// column right aligned as a whole. Content without a separator is aligned as if it were followed by one.
+	AlignDecimal
 )
+
+// SetDecimalSeparators sets the separator which each column is aligned on when using AlignDecimal. Should be
+// specified for each column in the supplied data. Any string can be used, such as a space to align values on their
+// units, or ":" to align times. Default separator for columns is "."
+func (t *Table) SetDecimalSeparators(columns ...string) {
+	t.decimalSeparators = columns
+}
+
+func (t *Table) getDecimalSeparator(colIndex int) string {
+	if column := t.namedColumn(colIndex); column != nil && column.decimalSeparator != "" {
+		return column.decimalSeparator
+	}
+	if colIndex >= len(t.decimalSeparators) || t.decimalSeparators[colIndex] == "" {
+		return "."
+	}
+	return t.decimalSeparators[colIndex]
+}
+
+// decimalWidth measures the parts of a line before and after (and including) the decimal separator
+func decimalWidth(line string, separator string) (int, int) {
+	line = newANSI(line).Strip()
+	index := strings.Index(line, separator)
+	if index < 0 {
+		return runewidth.StringWidth(line), 0
+	}
+	return runewidth.StringWidth(line[:index]), runewidth.StringWidth(line[index:])
+}
+
+// decimalWidths finds the widest parts either side of the decimal separator in each logical column, for cells which
+// are aligned on it
+func (t *Table) decimalWidths(formatted []iRow) ([]int, []int) {
+	count := t.calcColumnWidth(formatted[0])
+	lefts, rights := make([]int, count), make([]int, count)
+	for _, row := range formatted {
+		for c, col := range row.cols {
+			relative := t.getRelativeIndex(row, c)
+			if col.alignment != AlignDecimal || col.span > 1 || relative >= count {
+				continue
+			}
+			for _, line := range strings.Split(col.original, "\n") {
+				if line = strings.TrimSpace(line); line == "" {
+					continue
+				}
+				left, right := decimalWidth(line, t.getDecimalSeparator(relative))
+				if left > lefts[relative] {
+					lefts[relative] = left
+				}
+				if right > rights[relative] {
+					rights[relative] = right
+				}
+			}
+		}
+	}
+	return lefts, rights
+}
+
+// alignDecimal pads lines so their decimal separators line up
+func alignDecimal(lines []ansiBlob, separator string, left int, right int) []ansiBlob {
+	for i, line := range lines {
+		if line.Len() == 0 {
+			continue
+		}
+		l, r := decimalWidth(line.String(), separator)
+		if l > left || r > right {
+			// the line has been wrapped, so can't be aligned
+			continue
+		}
+		lines[i] = newANSI(strings.Repeat(" ", left-l) + line.String() + strings.Repeat(" ", right-r))
+	}
+	return lines
+}
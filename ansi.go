@@ -37,19 +37,43 @@ func (a ansiBlob) String() string {
 	return output
 }
 
-func (a ansiBlob) ANSI() string {
-	var output string
+// activeStyle returns the SGR sequences which are in effect at the end of the blob, given those which were in effect
+// at the start of it
+func (a ansiBlob) activeStyle(active string) string {
 	for _, segment := range a {
-		output += segment.style
+		for i := 0; i < len(segment.style); {
+			length, kind := escapeLength(segment.style[i:])
+			if length == 0 {
+				break
+			}
+			sequence := segment.style[i : i+length]
+			i += length
+			if kind != escapeSGR {
+				continue
+			}
+			switch params := sequence[2 : len(sequence)-1]; {
+			case !isStyleReset(sequence):
+				active += sequence
+			case strings.Trim(params, "0") == "":
+				active = ""
+			default:
+				// a leading reset discards everything before it
+				active = sequence
+			}
+		}
 	}
-	return simplifyANSI(output)
+	return active
 }
 
-func simplifyANSI(input string) string {
-	parts := strings.Split(input, "\x1b[0m")
-	return parts[len(parts)-1]
+// isStyleReset checks whether an SGR sequence resets styles, discarding those which were in effect before it
+func isStyleReset(sequence string) bool {
+	params := sequence[2 : len(sequence)-1]
+	return params == "" || strings.Trim(params, "0") == "" || strings.HasPrefix(params, "0;") || strings.HasPrefix(params, ";")
 }
 
+// resetStyle resets all SGR styles
+const resetStyle = "\x1b[0m"
+
 func (a ansiBlob) Cut(index int) (ansiBlob, ansiBlob) {
 	var current int
 	var outputBefore string
@@ -62,7 +86,7 @@ func (a ansiBlob) Cut(index int) (ansiBlob, ansiBlob) {
 		}
 		if index < current+utf8.RuneCountInString(segment.value) {
 			localIndex := index - current
-			outputBefore += string([]rune(segment.value)[:localIndex])
+			outputBefore += segment.style + string([]rune(segment.value)[:localIndex])
 			outputAfter = segment.style + string([]rune(segment.value)[localIndex:])
 			found = true
 			continue
@@ -75,15 +99,30 @@ func (a ansiBlob) Cut(index int) (ansiBlob, ansiBlob) {
 
 func (a ansiBlob) Words() []ansiBlob {
 	var output []ansiBlob
-	words := strings.Split(a.String(), " ")
-	var ansi string
-	for _, word := range words {
-		w := newANSI(word).TrimSpace()
-		ansi = simplifyANSI(ansi + w.ANSI())
-		if w.Len() == 0 {
-			continue
+	var current ansiBlob
+	// styles without any content are kept for the next word, so they aren't lost with the spaces around them
+	flush := func() {
+		if current.Len() > 0 {
+			output = append(output, current)
+			current = nil
+		}
+	}
+	// split on spaces in the content only, so that escape sequences containing spaces are kept intact
+	for _, segment := range a {
+		for i, part := range strings.Split(segment.value, " ") {
+			style := segment.style
+			if i > 0 {
+				flush()
+				style = ""
+			}
+			if part != "" || style != "" {
+				current = append(current, ansiSegment{value: part, style: style})
+			}
 		}
-		output = append(output, w)
+	}
+	flush()
+	if len(current) > 0 && len(output) > 0 {
+		output[len(output)-1] = append(output[len(output)-1], current...)
 	}
 	return output
 }
@@ -96,29 +135,132 @@ type ansiSegment struct {
 func newANSI(input string) ansiBlob {
 	var output []ansiSegment
 	var current ansiSegment
-	inCSI := false
-	prev := rune(0)
-	for _, r := range input {
-		if inCSI {
-			current.style += string(r)
-			if r >= 0x40 && r <= 0x7E {
-				inCSI = false
+	for i := 0; i < len(input); {
+		if input[i] == 0x1b {
+			length, kind := escapeLength(input[i:])
+			if length == 0 {
+				// drop an unterminated sequence, as it would otherwise consume whatever is rendered after it
+				break
 			}
-		} else if r == '[' && prev == 0x1b {
-			current.value = current.value[:utf8.RuneCountInString(current.value)-1]
-			if current.value != "" {
-				output = append(output, current)
-				current = ansiSegment{}
+			if kind != escapeInvalid {
+				if current.value != "" {
+					output = append(output, current)
+					current = ansiSegment{}
+				}
+				current.style += input[i : i+length]
 			}
-			inCSI = true
-			current.style += "\x1b["
-		} else {
-			current.value = current.value + string(r)
+			i += length
+			continue
 		}
-		prev = r
+		_, size := utf8.DecodeRuneInString(input[i:])
+		current.value += input[i : i+size]
+		i += size
 	}
 	if current.value != "" || current.style != "" {
 		output = append(output, current)
 	}
 	return output
 }
+
+type escapeKind uint8
+
+const (
+	escapeInvalid escapeKind = iota
+	// escapeSGR is a CSI sequence which sets styles, e.g. ESC [ 31 m
+	escapeSGR
+	// escapeCSI is any other CSI sequence, e.g. cursor movement
+	escapeCSI
+	// escapeOSC is an operating system command, e.g. a hyperlink or window title
+	escapeOSC
+	// escapeString is a DCS, SOS, PM or APC sequence
+	escapeString
+	// escapeShort is a two character (plus intermediates) escape, e.g. ESC 7
+	escapeShort
+)
+
+// escapeLength returns the length in bytes of the escape sequence at the start of the input, and its kind.
+// A length of zero means the sequence is unterminated. An invalid escape has a length of one, covering just the ESC.
+func escapeLength(input string) (int, escapeKind) {
+	if len(input) < 2 {
+		return 0, escapeInvalid
+	}
+	switch input[1] {
+	case '[':
+		var intermediate bool
+		for i := 2; i < len(input); i++ {
+			c := input[i]
+			switch {
+			case c >= 0x30 && c <= 0x3f: // parameter bytes
+				if intermediate {
+					return 1, escapeInvalid
+				}
+			case c >= 0x20 && c <= 0x2f: // intermediate bytes
+				intermediate = true
+			case c >= 0x40 && c <= 0x7e: // final byte
+				if c == 'm' && !intermediate && strings.Trim(input[2:i], "0123456789;:") == "" {
+					return i + 1, escapeSGR
+				}
+				return i + 1, escapeCSI
+			default:
+				return 1, escapeInvalid
+			}
+		}
+		return 0, escapeInvalid
+	case ']', 'P', 'X', '^', '_':
+		kind := escapeString
+		if input[1] == ']' {
+			kind = escapeOSC
+		}
+		for i := 2; i < len(input); i++ {
+			switch {
+			case input[i] == 0x07 && kind == escapeOSC: // BEL
+				return i + 1, kind
+			case input[i] == 0x1b && i+1 < len(input) && input[i+1] == '\\': // ST
+				return i + 2, kind
+			case input[i] == 0x1b && i+1 == len(input):
+				return 0, escapeInvalid
+			case input[i] == 0x1b:
+				return 1, escapeInvalid
+			}
+		}
+		return 0, escapeInvalid
+	default:
+		for i := 1; i < len(input); i++ {
+			c := input[i]
+			switch {
+			case c >= 0x20 && c <= 0x2f: // intermediate bytes
+			case c >= 0x30 && c <= 0x7e:
+				return i + 1, escapeShort
+			default:
+				return 1, escapeInvalid
+			}
+		}
+		return 0, escapeInvalid
+	}
+}
+
+// hyperlink returns the OSC 8 sequence which is open at the end of the blob, if any, given the link which was open
+// at the start of it
+func (a ansiBlob) hyperlink(open string) string {
+	for _, segment := range a {
+		for i := 0; i < len(segment.style); {
+			length, kind := escapeLength(segment.style[i:])
+			if length == 0 {
+				break
+			}
+			sequence := segment.style[i : i+length]
+			if kind == escapeOSC && strings.HasPrefix(sequence, "\x1b]8;") {
+				// an empty url closes the link
+				open = ""
+				if hyperlinkURL(sequence) != "" {
+					open = sequence
+				}
+			}
+			i += length
+		}
+	}
+	return open
+}
+
+// closeHyperlink ends any open OSC 8 hyperlink
+const closeHyperlink = "\x1b]8;;\x1b\\"
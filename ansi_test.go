@@ -28,6 +28,12 @@ func Test_CSIStrip(t *testing.T) {
 	}
 }
 
+func Test_ANSIMultiByteBeforeEscape(t *testing.T) {
+	blob := newANSI("日本\x1b[31mred")
+	assert.Equal(t, "日本red", blob.Strip())
+	assert.Equal(t, "日本\x1b[31mred", blob.String())
+}
+
 func Test_ANSILen(t *testing.T) {
 	tests := []struct {
 		input string
@@ -53,3 +59,54 @@ func Test_ANSILen(t *testing.T) {
 		})
 	}
 }
+
+func Test_ANSIEscapeSequences(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "hyperlink with ST", input: "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", want: "link"},
+		{name: "hyperlink with BEL", input: "\x1b]8;id=1;https://example.com\x07link\x1b]8;;\x07", want: "link"},
+		{name: "window title", input: "\x1b]0;title\x07text", want: "text"},
+		{name: "dcs", input: "a\x1bPq#0\x1b\\b", want: "ab"},
+		{name: "apc", input: "a\x1b_data\x1b\\b", want: "ab"},
+		{name: "cursor movement", input: "a\x1b[2Ab", want: "ab"},
+		{name: "private mode", input: "a\x1b[?25lb", want: "ab"},
+		{name: "save cursor", input: "a\x1b7b", want: "ab"},
+		{name: "charset", input: "a\x1b(Bb", want: "ab"},
+		{name: "unterminated osc", input: "a\x1b]8;;https://example.com", want: "a"},
+		{name: "unterminated csi", input: "a\x1b[31", want: "a"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blob := newANSI(test.input)
+			assert.Equal(t, test.want, blob.Strip())
+			assert.Equal(t, len(test.want), blob.Len())
+		})
+	}
+}
+
+func Test_ANSIHyperlinkState(t *testing.T) {
+	open := "\x1b]8;;https://example.com\x1b\\"
+	assert.Equal(t, open, newANSI(open+"link").hyperlink(""))
+	assert.Equal(t, "", newANSI(open+"link"+closeHyperlink).hyperlink(""))
+	assert.Equal(t, open, newANSI("more").hyperlink(open))
+	assert.Equal(t, "", newANSI("end"+closeHyperlink).hyperlink(open))
+}
+
+func Test_IsStyleReset(t *testing.T) {
+	tests := map[string]bool{
+		"\x1b[m":       true,
+		"\x1b[0m":      true,
+		"\x1b[00m":     true,
+		"\x1b[0;31m":   true,
+		"\x1b[;1m":     true,
+		"\x1b[31m":     false,
+		"\x1b[1;31m":   false,
+		"\x1b[38;5;0m": false,
+	}
+	for sequence, expected := range tests {
+		assert.Equal(t, expected, isStyleReset(sequence), "%q", sequence)
+	}
+}
@@ -0,0 +1,314 @@
+// Command table renders CSV, TSV or JSON data as a table.
+//
+// Usage:
+//
+//	table [flags] [file...]
+//
+// Data is read from the given files, or from stdin if no files are given. CSV and TSV files must have the same
+// headers as each other, while the columns of JSON files are the keys found across all of them.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aquasecurity/table"
+)
+
+type options struct {
+	input            string
+	headerRows       int
+	columns          string
+	autoAlign        bool
+	dividers         string
+	noBorders        bool
+	noRowLines       bool
+	align            string
+	headerAlign      string
+	autoMerge        bool
+	autoMergeHeaders bool
+	headerColspans   []string
+	width            int
+	padding          int
+	format           string
+}
+
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, " ")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// errUsage is returned for invalid flags, which the flag package has already reported along with the usage
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout)
+	switch {
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "table: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	var opts options
+	var colspans listFlag
+
+	flags := flag.NewFlagSet("table", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: table [flags] [file...]\n\nRenders CSV, TSV, JSON or NDJSON data from files or stdin as a table.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&opts.input, "input", "", "input format: csv, tsv, json or ndjson (detected from the file extension by default, otherwise csv)")
+	flags.IntVar(&opts.headerRows, "header-rows", 1, "number of rows at the start of CSV/TSV input to use as headers")
+	flags.StringVar(&opts.columns, "columns", "", "comma-separated indexes of the CSV/TSV columns to show, in order")
+	flags.BoolVar(&opts.autoAlign, "auto-align", false, "right-align numeric CSV/TSV columns, unless --align is set")
+	flags.StringVar(&opts.dividers, "dividers", "unicode", "divider theme: "+strings.Join(table.ThemeNames(), ", "))
+	flags.BoolVar(&opts.noBorders, "no-borders", false, "disable the border around the table")
+	flags.BoolVar(&opts.noRowLines, "no-row-lines", false, "disable lines between rows")
+	flags.StringVar(&opts.align, "align", "", "comma-separated column alignments (left, right, center)")
+	flags.StringVar(&opts.headerAlign, "header-align", "", "comma-separated header alignments (left, right, center)")
+	flags.BoolVar(&opts.autoMerge, "auto-merge", false, "merge identical adjacent cells vertically")
+	flags.BoolVar(&opts.autoMergeHeaders, "auto-merge-headers", false, "merge identical adjacent header cells vertically")
+	flags.Var(&colspans, "header-colspans", "comma-separated column spans for a header row, may be repeated for each header row")
+	flags.IntVar(&opts.width, "width", 0, "available width (defaults to the terminal width)")
+	flags.IntVar(&opts.padding, "padding", 1, "padding either side of cell content")
+	flags.StringVar(&opts.format, "format", "table", "output format: table, markdown, html or csv")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	opts.headerColspans = colspans
+
+	t := table.New(stdout)
+	if err := configure(t, opts); err != nil {
+		return err
+	}
+
+	if files := flags.Args(); len(files) > 0 {
+		if err := loadFiles(t, files, opts); err != nil {
+			return err
+		}
+	} else if err := load(t, stdin, opts.input, opts); err != nil {
+		return err
+	}
+
+	for i, spans := range opts.headerColspans {
+		values, err := parseInts(spans)
+		if err != nil {
+			return fmt.Errorf("invalid header colspans %q: %w", spans, err)
+		}
+		t.SetHeaderColSpans(i, values...)
+	}
+
+	t.Render()
+	return nil
+}
+
+func configure(t *table.Table, opts options) error {
+	theme, ok := table.LookupTheme(opts.dividers)
+	if !ok {
+		return fmt.Errorf("unknown dividers %q, expected one of: %s", opts.dividers, strings.Join(table.ThemeNames(), ", "))
+	}
+	t.SetTheme(theme)
+	if opts.noBorders {
+		t.SetBorders(false)
+	}
+	if opts.noRowLines {
+		t.SetRowLines(false)
+	}
+	if opts.align != "" {
+		alignments, err := parseAlignments(opts.align)
+		if err != nil {
+			return err
+		}
+		t.SetAlignment(alignments...)
+	}
+	if opts.headerAlign != "" {
+		alignments, err := parseAlignments(opts.headerAlign)
+		if err != nil {
+			return err
+		}
+		t.SetHeaderAlignment(alignments...)
+	}
+	t.SetAutoMerge(opts.autoMerge)
+	t.SetAutoMergeHeaders(opts.autoMergeHeaders)
+	if opts.width > 0 {
+		t.SetAvailableWidth(opts.width)
+	}
+	t.SetPadding(opts.padding)
+
+	switch opts.format {
+	case "table", "":
+		t.SetFormat(table.FormatTerminal)
+	case "markdown", "md":
+		t.SetFormat(table.FormatMarkdown)
+	case "html":
+		t.SetFormat(table.FormatHTML)
+	case "csv":
+		t.SetFormat(table.FormatCSV)
+	default:
+		return fmt.Errorf("unknown format %q, expected one of: table, markdown, html, csv", opts.format)
+	}
+	return nil
+}
+
+// loadFiles loads each file in turn, using the format given by --input or detected from its extension
+func loadFiles(t *table.Table, paths []string, opts options) error {
+	inputs := make([]string, len(paths))
+	var jsonFiles int
+	for i, path := range paths {
+		inputs[i] = opts.input
+		if inputs[i] == "" {
+			inputs[i] = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+		}
+		if isJSON(inputs[i]) {
+			jsonFiles++
+		}
+	}
+	if jsonFiles > 0 && jsonFiles < len(paths) {
+		return fmt.Errorf("cannot mix JSON and CSV/TSV input files, as their columns are found differently")
+	}
+
+	if jsonFiles > 0 {
+		// the files are loaded as a single stream, so the headers include the keys from every file
+		var readers []io.Reader
+		for _, path := range paths {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = f.Close() }()
+			readers = append(readers, f, strings.NewReader("\n"))
+		}
+		return t.LoadJSON(io.MultiReader(readers...))
+	}
+
+	if err := loadFile(t, paths[0], inputs[0], opts); err != nil {
+		return err
+	}
+	// later files must have the same headers as the first, and only their rows are added
+	for i, path := range paths[1:] {
+		next := table.New(io.Discard)
+		if err := loadFile(next, path, inputs[i+1], opts); err != nil {
+			return err
+		}
+		if !sameRows(next.Headers(), t.Headers()) {
+			return fmt.Errorf("%s has different headers to %s", path, paths[0])
+		}
+		for r := 0; r < next.RowCount(); r++ {
+			t.AddRow(next.Row(r)...)
+		}
+	}
+	return nil
+}
+
+func sameRows(a [][]string, b [][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for r := range a {
+		if len(a[r]) != len(b[r]) {
+			return false
+		}
+		for c := range a[r] {
+			if a[r][c] != b[r][c] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func loadFile(t *table.Table, path string, input string, opts options) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	return load(t, f, input, opts)
+}
+
+func isJSON(input string) bool {
+	return input == "json" || input == "ndjson" || input == "jsonl"
+}
+
+func load(t *table.Table, r io.Reader, input string, opts options) error {
+	switch input {
+	case "csv", "":
+		return loadDelimited(t, r, ',', opts)
+	case "tsv", "tab":
+		return loadDelimited(t, r, '\t', opts)
+	case "json", "ndjson", "jsonl":
+		return t.LoadJSON(r)
+	default:
+		return fmt.Errorf("unknown input format %q, expected one of: csv, tsv, json, ndjson", input)
+	}
+}
+
+func loadDelimited(t *table.Table, r io.Reader, delimiter rune, opts options) error {
+	csvOpts := table.CSVOptions{
+		Delimiter:      delimiter,
+		VariableFields: true,
+		StripBOM:       true,
+		HeaderRows:     opts.headerRows,
+		AutoAlign:      opts.autoAlign && opts.align == "",
+	}
+	if opts.columns != "" {
+		columns, err := parseInts(opts.columns)
+		if err != nil {
+			return fmt.Errorf("invalid columns %q: %w", opts.columns, err)
+		}
+		csvOpts.Columns = columns
+	}
+	if err := t.LoadCSVWithOptions(r, csvOpts); err != nil && err != io.EOF {
+		return err
+	}
+	// empty input renders an empty table
+	return nil
+}
+
+func parseAlignments(input string) ([]table.Alignment, error) {
+	var alignments []table.Alignment
+	for _, value := range strings.Split(input, ",") {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "left", "l", "":
+			alignments = append(alignments, table.AlignLeft)
+		case "right", "r":
+			alignments = append(alignments, table.AlignRight)
+		case "center", "centre", "c":
+			alignments = append(alignments, table.AlignCenter)
+		default:
+			return nil, fmt.Errorf("unknown alignment %q, expected left, right or center", value)
+		}
+	}
+	return alignments, nil
+}
+
+func parseInts(input string) ([]int, error) {
+	var values []int
+	for _, value := range strings.Split(input, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, i)
+	}
+	return values, nil
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		files   map[string]string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "csv from stdin",
+			stdin: "Name,Count\napples,3\n",
+			want: `
+┌────────┬───────┐
+│  Name  │ Count │
+├────────┼───────┤
+│ apples │ 3     │
+└────────┴───────┘
+`,
+		},
+		{
+			name:  "tsv",
+			args:  []string{"--input", "tsv", "--no-row-lines", "--dividers", "ascii"},
+			stdin: "Name\tCount\napples\t3\npears\t12\n",
+			want: `
++--------+-------+
+|  Name  | Count |
++--------+-------+
+| apples | 3     |
+| pears  | 12    |
++--------+-------+
+`,
+		},
+		{
+			name:  "json",
+			args:  []string{"--input", "json", "--format", "csv"},
+			stdin: `[{"name": "apples", "stock": {"count": 3}}]`,
+			want: `
+name,stock.count
+apples,3
+`,
+		},
+		{
+			name:  "ndjson",
+			args:  []string{"--input", "ndjson", "--format", "markdown"},
+			stdin: "{\"name\": \"apples\"}\n{\"name\": \"pears\"}\n",
+			want: `
+|  name  |
+|--------|
+| apples |
+| pears  |
+`,
+		},
+		{
+			name:  "alignment and columns",
+			args:  []string{"--columns", "1,0", "--align", "right,left", "--header-align", "left,left", "--no-borders"},
+			stdin: "Name,Count\napples,3\npears,12\n",
+			want: "\n" +
+				" Count │ Name   \n" +
+				"───────┼────────\n" +
+				"     3 │ apples \n" +
+				"───────┼────────\n" +
+				"    12 │ pears  \n",
+		},
+		{
+			name:  "auto align",
+			args:  []string{"--auto-align", "--format", "html"},
+			stdin: "Name,Count\napples,3\n",
+			want: `
+<table>
+<thead>
+<tr><th>Name</th><th>Count</th></tr>
+</thead>
+<tbody>
+<tr><td>apples</td><td style="text-align: right">3</td></tr>
+</tbody>
+</table>
+`,
+		},
+		{
+			name:  "header rows and colspans",
+			args:  []string{"--header-rows", "2", "--header-colspans", "1,2", "--auto-merge-headers", "--padding", "0"},
+			stdin: "Name,Stock\nName,In,Out\napples,3,1\n",
+			want: `
+┌──────┬──────┐
+│ Name │Stock │
+│      ├──┬───┤
+│      │In│Out│
+├──────┼──┼───┤
+│apples│3 │1  │
+└──────┴──┴───┘
+`,
+		},
+		{
+			name:  "empty input",
+			stdin: "",
+			want:  "\n",
+		},
+		{
+			name:  "csv files",
+			args:  []string{"--format", "csv", "a.csv", "b.csv"},
+			files: map[string]string{"a.csv": "Name\napples\n", "b.csv": "Name\npears\n"},
+			want: `
+Name
+apples
+pears
+`,
+		},
+		{
+			name:  "json files with different keys",
+			args:  []string{"--format", "csv", "a.json", "b.ndjson"},
+			files: map[string]string{"a.json": `[{"name": "apples"}]`, "b.ndjson": `{"name": "pears", "count": 2}`},
+			want: `
+name,count
+apples,
+pears,2
+`,
+		},
+		{
+			name:    "csv files with different headers",
+			args:    []string{"a.csv", "b.tsv"},
+			files:   map[string]string{"a.csv": "Name\napples\n", "b.tsv": "Fruit\npears\n"},
+			wantErr: "b.tsv has different headers to a.csv",
+		},
+		{
+			name:    "mixed json and csv files",
+			args:    []string{"a.json", "b.csv"},
+			files:   map[string]string{"a.json": `[{"name": "apples"}]`, "b.csv": "name\npears\n"},
+			wantErr: "cannot mix JSON and CSV/TSV input files, as their columns are found differently",
+		},
+		{
+			name: "help",
+			args: []string{"-h"},
+			want: "\n",
+		},
+		{
+			name:    "unknown flag",
+			args:    []string{"--colour"},
+			wantErr: "invalid usage",
+		},
+		{
+			name:    "unknown format",
+			args:    []string{"--format", "xml"},
+			wantErr: `unknown format "xml", expected one of: table, markdown, html, csv`,
+		},
+		{
+			name:    "unknown alignment",
+			args:    []string{"--align", "middle"},
+			wantErr: `unknown alignment "middle", expected left, right or center`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+			}
+			var args []string
+			for _, arg := range test.args {
+				if _, ok := test.files[arg]; ok {
+					arg = filepath.Join(dir, arg)
+				}
+				args = append(args, arg)
+			}
+
+			output := &strings.Builder{}
+			err := run(args, strings.NewReader(test.stdin), output)
+			if test.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, test.wantErr, strings.ReplaceAll(err.Error(), dir+string(filepath.Separator), ""))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, "\n"+output.String())
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package table
+
+import "fmt"
+
+// Column configures a column by the name of its header, so that settings stay with the column if columns are added
+// or moved. Names are resolved against the last header row when the table is rendered, and take precedence over
+// settings made by position, such as those made with SetAlignment.
+type Column struct {
+	name             string
+	alignment        *Alignment
+	headerAlignment  *Alignment
+	footerAlignment  *Alignment
+	wrapping         *Wrapping
+	decimalSeparator string
+	maxWidth         int
+	link             func(string) string
+}
+
+// Column returns the column with the given header name, for configuring it, e.g.
+//
+//	t.Column("Severity").SetAlignment(AlignCenter).SetMaxWidth(10)
+//
+// Use Validate to check that every configured name matches a header.
+func (t *Table) Column(name string) *Column {
+	for _, column := range t.columns {
+		if column.name == name {
+			return column
+		}
+	}
+	column := &Column{name: name}
+	t.columns = append(t.columns, column)
+	return column
+}
+
+// Validate checks that every column configured with Column matches a header in the last header row
+func (t *Table) Validate() error {
+	names := t.columnNames()
+	for _, column := range t.columns {
+		if _, ok := names[column.name]; !ok {
+			return fmt.Errorf("unknown column %q", column.name)
+		}
+	}
+	return nil
+}
+
+// SetAlignment sets the alignment of the column. Same as SetAlignment on the table.
+func (c *Column) SetAlignment(a Alignment) *Column {
+	c.alignment = &a
+	return c
+}
+
+// SetHeaderAlignment sets the alignment of the header. Same as SetHeaderAlignment on the table.
+func (c *Column) SetHeaderAlignment(a Alignment) *Column {
+	c.headerAlignment = &a
+	return c
+}
+
+// SetFooterAlignment sets the alignment of the footer. Same as SetFooterAlignment on the table.
+func (c *Column) SetFooterAlignment(a Alignment) *Column {
+	c.footerAlignment = &a
+	return c
+}
+
+// SetWrapping sets how the content of the column is wrapped. Same as SetWrapping on the table.
+func (c *Column) SetWrapping(w Wrapping) *Column {
+	c.wrapping = &w
+	return c
+}
+
+// SetDecimalSeparator sets the separator the column is aligned on with AlignDecimal. Same as SetDecimalSeparators
+// on the table.
+func (c *Column) SetDecimalSeparator(separator string) *Column {
+	c.decimalSeparator = separator
+	return c
+}
+
+// SetMaxWidth sets the maximum width of the column, which unlike SetColumnMaxWidth on the table applies even when
+// the table fits within the available width
+func (c *Column) SetMaxWidth(width int) *Column {
+	if width < 1 {
+		width = 1
+	}
+	c.maxWidth = width
+	return c
+}
+
+// SetLinks sets a function which returns the URL each data cell in the column should link to. Same as
+// SetColumnLinks on the table.
+func (c *Column) SetLinks(url func(value string) string) *Column {
+	c.link = url
+	return c
+}
+
+// columnNames maps the names in the last header row to the logical columns they start at
+func (t *Table) columnNames() map[string]int {
+	names := make(map[string]int)
+	if len(t.headers) == 0 {
+		return names
+	}
+	last := len(t.headers) - 1
+	var relative int
+	for c, name := range t.headers[last] {
+		if _, ok := names[name]; !ok {
+			names[name] = relative
+		}
+		relative += t.getColspan(true, false, last, c)
+	}
+	return names
+}
+
+// resolveColumns maps logical columns to the columns configured by name
+func (t *Table) resolveColumns() map[int]*Column {
+	resolved := make(map[int]*Column)
+	names := t.columnNames()
+	for _, column := range t.columns {
+		if i, ok := names[column.name]; ok {
+			if _, exists := resolved[i]; !exists {
+				resolved[i] = column
+			}
+		}
+	}
+	return resolved
+}
+
+// resolveNamedColumns resolves the names of columns once for a pass over the table, such as a render, returning a
+// function which clears them at the end of the pass. Passes which are nested inside another keep its names.
+func (t *Table) resolveNamedColumns() func() {
+	if t.namedColumns != nil {
+		return func() {}
+	}
+	t.namedColumns = t.resolveColumns()
+	return func() { t.namedColumns = nil }
+}
+
+// namedColumn finds the column configured by name for a logical column, if any. Names are resolved on each call,
+// unless they have already been resolved for the current pass over the table.
+func (t *Table) namedColumn(index int) *Column {
+	if len(t.columns) == 0 {
+		return nil
+	}
+	if t.namedColumns != nil {
+		return t.namedColumns[index]
+	}
+	return t.resolveColumns()[index]
+}
+
+// getMaxWidth finds the maximum width of a logical column
+func (t *Table) getMaxWidth(colIndex int) int {
+	if column := t.namedColumn(colIndex); column != nil && column.maxWidth > 0 {
+		return column.maxWidth
+	}
+	return t.maxColumnWidth
+}
@@ -0,0 +1,186 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ColumnByName(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("ID", "Severity", "Title")
+	table.SetAlignment(AlignRight, AlignLeft, AlignLeft)
+	table.Column("Severity").SetAlignment(AlignCenter)
+	table.Column("Title").SetMaxWidth(10)
+	table.AddRow("1", "HIGH", "A rather long title for this")
+	table.AddRow("22", "LOW", "Short")
+	table.Render()
+	assertMultilineEqual(t, `
+┌────┬──────────┬────────────┐
+│ ID │ Severity │   Title    │
+├────┼──────────┼────────────┤
+│  1 │   HIGH   │ A rather   │
+│    │          │ long title │
+│    │          │ for this   │
+├────┼──────────┼────────────┤
+│ 22 │   LOW    │ Short      │
+└────┴──────────┴────────────┘
+`, "\n"+builder.String())
+}
+
+func Test_ColumnByNameFollowsHeaders(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	// configured before the headers are known
+	table.Column("Count").SetAlignment(AlignRight).SetHeaderAlignment(AlignRight).SetFooterAlignment(AlignRight)
+	table.SetHeaders("Name", "Colour", "Count")
+	table.AddRow("apples", "red", "3")
+	table.SetFooters("Total", "", "3")
+	table.Render()
+	assertMultilineEqual(t, `
+┌────────┬────────┬───────┐
+│  Name  │ Colour │ Count │
+├────────┼────────┼───────┤
+│ apples │ red    │     3 │
+├────────┼────────┼───────┤
+│ Total  │        │     3 │
+└────────┴────────┴───────┘
+`, "\n"+builder.String())
+}
+
+func Test_ColumnByNameMultipleHeaderRows(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "Stats")
+	table.AddHeaders("Name", "Min", "Max")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.Column("Max").SetAlignment(AlignRight)
+	table.AddRow("a", "1", "10")
+	table.AddRow("b", "100", "2")
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────┬───────────┐
+│ Name │   Stats   │
+├──────┼─────┬─────┤
+│ Name │ Min │ Max │
+├──────┼─────┼─────┤
+│ a    │ 1   │  10 │
+├──────┼─────┼─────┤
+│ b    │ 100 │   2 │
+└──────┴─────┴─────┘
+`, "\n"+builder.String())
+}
+
+func Test_ColumnValidate(t *testing.T) {
+	table := New(&strings.Builder{})
+	table.SetHeaders("A", "B")
+	table.Column("B").SetWrapping(WrapNoHyphen)
+	assert.NoError(t, table.Validate())
+	table.Column("C").SetDecimalSeparator(",")
+	assert.EqualError(t, table.Validate(), `unknown column "C"`)
+}
+
+func Test_ColumnSameName(t *testing.T) {
+	table := New(&strings.Builder{})
+	assert.Same(t, table.Column("A"), table.Column("A"))
+}
+
+func Test_ColumnByNameWithColSpans(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B", "C")
+	table.Column("B").SetLinks(func(value string) string {
+		return "https://example.com/" + value
+	})
+	table.Column("C").SetAlignment(AlignRight)
+	table.SetSanitize(SanitizeStylesAndLinks)
+	table.AddRow("x1", "y1", "z100")
+	table.AddRow("wide", "z2")
+	table.SetColSpans(1, 2, 1)
+	table.Render()
+	assertMultilineEqual(t, `
+┌────┬────┬──────┐
+│ A  │ B  │  C   │
+├────┼────┼──────┤
+│ x1 │ \x1b]8;;https://example.com/y1\x1b\y1\x1b]8;;\x1b\ │ z100 │
+├────┴────┼──────┤
+│ wide    │   z2 │
+└─────────┴──────┘
+`, "\n"+strings.ReplaceAll(builder.String(), "\x1b", `\x1b`))
+}
+
+func Test_ColumnUnknownName(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.Column("Typo").SetAlignment(AlignRight)
+	assert.EqualError(t, table.Validate(), `unknown column "Typo"`)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 1 │ 2 │
+└───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_ColumnLinksMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		numbers  bool
+		expected string
+	}{
+		{
+			name: "plain",
+			expected: `
+|            ID            | Severity |
+|--------------------------|----------|
+| [CVE-1](https://x/CVE-1) | HIGH     |
+`,
+		},
+		{
+			name:    "row numbers",
+			numbers: true,
+			expected: `
+| # |            ID            | Severity |
+|---|--------------------------|----------|
+| 1 | [CVE-1](https://x/CVE-1) | HIGH     |
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			builder := &strings.Builder{}
+			table := New(builder)
+			table.SetFormat(FormatMarkdown)
+			table.SetRowNumbers(test.numbers, 1, "#")
+			table.SetHeaders("ID", "Severity")
+			table.AddRow("CVE-1", "HIGH")
+			table.Column("ID").SetLinks(func(value string) string {
+				return "https://x/" + value
+			})
+			table.Render()
+			assertMultilineEqual(t, test.expected, "\n"+builder.String())
+		})
+	}
+}
+
+func Test_ColumnNamesResolvedOncePerPass(t *testing.T) {
+	table := New(&strings.Builder{})
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.Column("B").SetAlignment(AlignRight)
+	done := table.resolveNamedColumns()
+	resolved := table.namedColumns
+	table.formatData()
+	assert.Equal(t, resolved, table.namedColumns)
+	// nested passes keep the names resolved by the outer pass
+	table.resolveNamedColumns()()
+	assert.NotNil(t, table.namedColumns)
+	done()
+	assert.Nil(t, table.namedColumns)
+}
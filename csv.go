@@ -0,0 +1,144 @@
+package table
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVOptions configures how CSV data is loaded by LoadCSVWithOptions
+type CSVOptions struct {
+	// Delimiter separates fields within a record. Defaults to ','
+	Delimiter rune
+	// Comment causes lines beginning with this character to be ignored. Disabled by default.
+	Comment rune
+	// LazyQuotes allows quotes to appear in unquoted fields, and non-doubled quotes in quoted fields
+	LazyQuotes bool
+	// VariableFields allows records to contain differing numbers of fields
+	VariableFields bool
+	// StripBOM removes a UTF-8 byte order mark from the start of the data
+	StripBOM bool
+	// HeaderRows is the number of records at the start of the data which are added as headers
+	HeaderRows int
+	// Columns selects which columns to load by index, in the order given. All columns are loaded by default.
+	Columns []int
+	// AutoAlign right-aligns columns where every non-empty value is numeric
+	AutoAlign bool
+}
+
+// LoadCSVWithOptions loads CSV data from a reader and adds it to the table. Existing rows/headers/footers are retained.
+// io.EOF is returned if the data ends before all of the header rows have been read.
+func (t *Table) LoadCSVWithOptions(r io.Reader, opts CSVOptions) error {
+	return t.loadCSV(r, opts, false)
+}
+
+// loadCSV loads CSV data, either adding to or replacing any existing headers once all header rows have been read
+func (t *Table) loadCSV(r io.Reader, opts CSVOptions, replaceHeaders bool) error {
+	if opts.StripBOM {
+		br := bufio.NewReader(r)
+		if bom, err := br.Peek(3); err == nil && string(bom) == "\xef\xbb\xbf" {
+			_, _ = br.Discard(3)
+		}
+		r = br
+	}
+
+	cr := csv.NewReader(r)
+	if opts.Delimiter != 0 {
+		cr.Comma = opts.Delimiter
+	}
+	cr.Comment = opts.Comment
+	cr.LazyQuotes = opts.LazyQuotes
+	if opts.VariableFields {
+		cr.FieldsPerRecord = -1
+	}
+
+	var headers, rows [][]string
+	for i := 0; ; i++ {
+		record, err := cr.Read()
+		if err != nil {
+			if err == io.EOF {
+				if len(headers) < opts.HeaderRows {
+					return io.EOF
+				}
+				break
+			}
+			return err
+		}
+		record = selectFields(record, opts.Columns)
+		if i < opts.HeaderRows {
+			headers = append(headers, record)
+			if len(headers) == opts.HeaderRows {
+				if replaceHeaders {
+					t.headers = nil
+				}
+				for _, header := range headers {
+					t.AddHeaders(header...)
+				}
+			}
+			continue
+		}
+		t.AddRow(record...)
+		rows = append(rows, record)
+	}
+
+	if opts.AutoAlign {
+		t.alignNumericColumns(rows)
+	}
+
+	return nil
+}
+
+func selectFields(record []string, columns []int) []string {
+	if columns == nil {
+		return record
+	}
+	selected := make([]string, len(columns))
+	for i, c := range columns {
+		if c >= 0 && c < len(record) {
+			selected[i] = record[c]
+		}
+	}
+	return selected
+}
+
+// alignNumericColumns right-aligns each column where every non-empty value in the given rows is a number
+func (t *Table) alignNumericColumns(rows [][]string) {
+	var numeric []bool
+	var seen []bool
+	for _, row := range rows {
+		for c, value := range row {
+			for c >= len(numeric) {
+				numeric = append(numeric, true)
+				seen = append(seen, false)
+			}
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			seen[c] = true
+			if !isNumeric(value) {
+				numeric[c] = false
+			}
+		}
+	}
+	for c := range numeric {
+		if !numeric[c] || !seen[c] {
+			continue
+		}
+		for c >= len(t.alignments) {
+			t.alignments = append(t.alignments, AlignLeft)
+		}
+		t.alignments[c] = AlignRight
+	}
+}
+
+func isNumeric(value string) bool {
+	value = strings.TrimSuffix(strings.ReplaceAll(value, ",", ""), "%")
+	if !strings.ContainsAny(value, "0123456789") {
+		return false
+	}
+	_, err := strconv.ParseFloat(value, 64)
+	return err == nil
+}
@@ -0,0 +1,64 @@
+package table
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LoadCSVWithOptions(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	require.NoError(t, table.LoadCSVWithOptions(strings.NewReader("\xef\xbb\xbf"+`Fruit;Stock;Price;Notes
+Fruit;Count;EUR;Notes
+# this line is ignored
+Apple;14;1.50;"crunchy"
+Banana;88,041;0.25
+Cherry;342;12.00;"the "best" one"
+`), CSVOptions{
+		Delimiter:      ';',
+		Comment:        '#',
+		LazyQuotes:     true,
+		VariableFields: true,
+		StripBOM:       true,
+		HeaderRows:     2,
+		Columns:        []int{0, 2, 1},
+		AutoAlign:      true,
+	}))
+	table.SetAutoMergeHeaders(true)
+	table.SetRowLines(false)
+	table.Render()
+	assertMultilineEqual(t, `
+┌────────┬───────┬────────┐
+│ Fruit  │ Price │ Stock  │
+│        ├───────┼────────┤
+│        │  EUR  │ Count  │
+├────────┼───────┼────────┤
+│ Apple  │  1.50 │     14 │
+│ Banana │  0.25 │ 88,041 │
+│ Cherry │ 12.00 │    342 │
+└────────┴───────┴────────┘
+`, "\n"+builder.String())
+}
+
+func Test_LoadCSVWithOptionsStrictFields(t *testing.T) {
+	table := New(&strings.Builder{})
+	require.Error(t, table.LoadCSVWithOptions(strings.NewReader("a,b\n1\n"), CSVOptions{}))
+}
+
+func Test_LoadCSVKeepsHeadersOnError(t *testing.T) {
+	table := New(&strings.Builder{})
+	table.SetHeaders("Existing")
+	err := table.LoadCSV(strings.NewReader(`"unterminated`), true)
+	require.Error(t, err)
+	assert.Equal(t, [][]string{{"Existing"}}, table.Headers())
+
+	assert.Equal(t, io.EOF, table.LoadCSV(strings.NewReader(""), true))
+	assert.Equal(t, [][]string{{"Existing"}}, table.Headers())
+
+	require.NoError(t, table.LoadCSV(strings.NewReader("A,B\n1,2\n"), true))
+	assert.Equal(t, [][]string{{"A", "B"}}, table.Headers())
+}
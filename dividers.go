@@ -1,5 +1,11 @@
 package table
 
+import (
+	"strings"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
 type Dividers struct {
 	ALL string
 	NES string
@@ -78,3 +84,117 @@ var MarkdownDividers = Dividers{
 	EW:  "-",
 	NS:  "|",
 }
+
+var DoubleDividers = Dividers{
+	ALL: "╬",
+	NES: "╠",
+	NSW: "╣",
+	NEW: "╩",
+	ESW: "╦",
+	NE:  "╚",
+	NW:  "╝",
+	SW:  "╗",
+	ES:  "╔",
+	EW:  "═",
+	NS:  "║",
+}
+var HeavyDividers = Dividers{
+	ALL: "╋",
+	NES: "┣",
+	NSW: "┫",
+	NEW: "┻",
+	ESW: "┳",
+	NE:  "┗",
+	NW:  "┛",
+	SW:  "┓",
+	ES:  "┏",
+	EW:  "━",
+	NS:  "┃",
+}
+var DashedDividers = Dividers{
+	ALL: "┼",
+	NES: "├",
+	NSW: "┤",
+	NEW: "┴",
+	ESW: "┬",
+	NE:  "└",
+	NW:  "┘",
+	SW:  "┐",
+	ES:  "┌",
+	EW:  "╌",
+	NS:  "╎",
+}
+var BlockDividers = Dividers{
+	ALL: "█",
+	NES: "█",
+	NSW: "█",
+	NEW: "█",
+	ESW: "█",
+	NE:  "█",
+	NW:  "█",
+	SW:  "█",
+	ES:  "█",
+	EW:  "█",
+	NS:  "█",
+}
+var OrgDividers = Dividers{
+	ALL: "+",
+	NES: "|",
+	NSW: "|",
+	NEW: "+",
+	ESW: "+",
+	NE:  "|",
+	NW:  "|",
+	SW:  "|",
+	ES:  "|",
+	EW:  "-",
+	NS:  "|",
+}
+
+// width returns the display width of the widest divider, excluding EW which is repeated to fill lines
+func (d Dividers) width() int {
+	var width int
+	for _, s := range []string{d.ALL, d.NES, d.NSW, d.NEW, d.ESW, d.NE, d.NW, d.SW, d.ES, d.NS} {
+		if w := runewidth.StringWidth(s); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// fillDivider repeats the given pattern until it reaches the required display width
+func fillDivider(pattern string, width int) string {
+	if pattern == "" || width <= 0 {
+		return ""
+	}
+	patternWidth := runewidth.StringWidth(pattern)
+	if patternWidth == 0 {
+		return ""
+	}
+	repeated := strings.Repeat(pattern, (width/patternWidth)+1)
+	return runewidth.FillRight(runewidth.Truncate(repeated, width, ""), width)
+}
+
+// padDivider pads a divider to the required display width, filling the remainder with the given pattern
+func padDivider(divider string, width int, fill string) string {
+	remaining := width - runewidth.StringWidth(divider)
+	if remaining <= 0 {
+		return divider
+	}
+	if fill == "" {
+		fill = " "
+	}
+	return divider + fillDivider(fill, remaining)
+}
+
+// padDividerLeft is the same as padDivider, but pads on the left, for use on the right edge of the table
+func padDividerLeft(divider string, width int, fill string) string {
+	remaining := width - runewidth.StringWidth(divider)
+	if remaining <= 0 {
+		return divider
+	}
+	if fill == "" {
+		fill = " "
+	}
+	return fillDivider(fill, remaining) + divider
+}
@@ -0,0 +1,70 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MultiCharacterDividers(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetDividers(Dividers{
+		ALL: "╬═",
+		NES: "╠═",
+		NSW: "╣",
+		NEW: "╩═",
+		ESW: "╦═",
+		NE:  "╚═",
+		NW:  "╝",
+		SW:  "╗",
+		ES:  "╔═",
+		EW:  "═",
+		NS:  "║",
+	})
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.Render()
+	assertMultilineEqual(t, `
+╔════╦═════╗
+║  A ║  B  ║
+╠════╬═════╣
+║  1 ║  2  ║
+╚════╩═════╝
+`, "\n"+builder.String())
+}
+
+func Test_HeaderFooterAndRowLineDividers(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetDividers(ASCIIDividers)
+	table.SetHeaderDividers(Dividers{ALL: "+", NES: "+", NSW: "+", EW: "="})
+	table.SetFooterDividers(Dividers{ALL: "+", NES: "+", NSW: "+", EW: "~"})
+	table.SetRowLineDividers(Dividers{ALL: ":", NES: ":", NSW: ":", EW: "."})
+	table.SetHeaders("A", "B")
+	table.SetFooters("X", "Y")
+	table.AddRow("1", "2")
+	table.AddRow("3", "4")
+	table.Render()
+	assertMultilineEqual(t, `
++---+---+
+| A | B |
++===+===+
+| 1 | 2 |
+:...:...:
+| 3 | 4 |
++~~~+~~~+
+| X | Y |
++---+---+
+`, "\n"+builder.String())
+}
+
+func Test_FillDivider(t *testing.T) {
+	assert.Equal(t, "─────", fillDivider("─", 5))
+	assert.Equal(t, "-=-=-", fillDivider("-=", 5))
+	assert.Equal(t, "", fillDivider("", 5))
+	assert.Equal(t, "╞══", padDivider("╞", 3, "═"))
+	assert.Equal(t, "║  ", padDivider("║", 3, ""))
+	assert.Equal(t, "══╡", padDividerLeft("╡", 3, "═"))
+}
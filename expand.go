@@ -0,0 +1,135 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExpandMode dictates whether each row is rendered as a vertical block of key/value pairs
+type ExpandMode uint8
+
+const (
+	// ExpandOff renders the table normally
+	ExpandOff ExpandMode = iota
+	// ExpandOn renders each row as a block of key/value pairs, using the headers as keys
+	ExpandOn
+	// ExpandAuto renders the table normally, unless its minimum width exceeds the available width
+	ExpandAuto
+)
+
+// SetExpanded sets whether to render each row as a vertical block of key/value pairs (similar to psql's \x mode).
+// Keys are taken from the headers, and footers are not rendered in expanded mode.
+func (t *Table) SetExpanded(mode ExpandMode) {
+	t.expandMode = mode
+}
+
+func (t *Table) shouldExpand() bool {
+	switch t.expandMode {
+	case ExpandOn:
+		return true
+	case ExpandAuto:
+		return t.minimumWidth() > t.availableWidth
+	default:
+		return false
+	}
+}
+
+// minimumWidth calculates the narrowest width the table can be rendered at without breaking words
+func (t *Table) minimumWidth() int {
+	widths := make([]int, t.findMaxCols())
+	dw := t.dividerWidth()
+	measure := func(rows [][]string, header bool, footer bool, spanned bool) {
+		for r, row := range rows {
+			var relative int
+			for c, value := range row {
+				span := t.getColspan(header, footer, r, c)
+				width := t.wordWidth(t.cellContent(value, relative), relative)
+				switch {
+				case spanned && span > 1:
+					spreadWidth(widths, relative, relative+span, width, (t.padding*2)+dw)
+				case !spanned && span == 1 && relative < len(widths) && width > widths[relative]:
+					widths[relative] = width
+				}
+				relative += span
+			}
+		}
+	}
+	// measure single cells first, then widen columns where spanned cells need more room
+	for _, spanned := range []bool{false, true} {
+		measure(t.headers, true, false, spanned)
+		measure(t.data, false, false, spanned)
+		measure(t.footers, false, true, spanned)
+	}
+
+	total := dw
+	for _, width := range widths {
+		total += width + (t.padding * 2) + dw
+	}
+	return total
+}
+
+// expandedKeys builds a key for each column, combining header rows where there are several
+func (t *Table) expandedKeys(maxCols int) []string {
+	keys := make([][]string, maxCols)
+	for r, row := range t.headers {
+		var relative int
+		for c, heading := range row {
+			span := t.getColspan(true, false, r, c)
+			for i := relative; i < relative+span && i < maxCols; i++ {
+				parts := keys[i]
+				if heading != "" && (len(parts) == 0 || parts[len(parts)-1] != heading) {
+					keys[i] = append(parts, heading)
+				}
+			}
+			relative += span
+		}
+	}
+	output := make([]string, maxCols)
+	for i, parts := range keys {
+		if len(parts) == 0 {
+			output[i] = fmt.Sprintf("Column %d", i+1)
+			continue
+		}
+		output[i] = strings.Join(parts, " / ")
+	}
+	return output
+}
+
+// expanded creates a two column table where each row of this table becomes a block of key/value pairs
+func (t *Table) expanded() *Table {
+	e := t.clone()
+	e.expandMode = ExpandOff
+	e.alignments = nil
+	e.headerAlignments = nil
+	e.footerAlignments = nil
+	e.wrapping = nil
+	e.decimalSeparators = nil
+	e.columnLinks = nil
+	e.columns = nil
+	e.autoMerge = false
+	e.autoMergeHeaders = false
+
+	e.separatedRows = make(map[int]bool)
+
+	maxCols := t.findMaxCols()
+	keys := t.expandedKeys(maxCols)
+
+	for r, row := range t.data {
+		e.AddRow(fmt.Sprintf("Record %d", r+1))
+		e.SetColSpans(len(e.data)-1, 2)
+		e.separatedRows[len(e.data)-1] = true
+		values := make([]string, maxCols)
+		var relative int
+		for c, value := range row {
+			if relative < maxCols {
+				values[relative] = value
+			}
+			relative += t.getColspan(false, false, r, c)
+		}
+		for c, key := range keys {
+			e.AddRow(key, values[c])
+		}
+	}
+
+	return e
+}
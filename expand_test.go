@@ -0,0 +1,84 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_Expanded(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("ID", "Fruit", "Stock")
+	table.SetRowLines(false)
+	table.SetExpanded(ExpandOn)
+	table.AddRow("1", "Apple", "14")
+	table.AddRow("2", "Banana", "88,041")
+	table.Render()
+	assertMultilineEqual(t, `
+┌────────────────┐
+│ Record 1       │
+│ ID    │ 1      │
+│ Fruit │ Apple  │
+│ Stock │ 14     │
+├───────┴────────┤
+│ Record 2       │
+│ ID    │ 2      │
+│ Fruit │ Banana │
+│ Stock │ 88,041 │
+└───────┴────────┘
+`, "\n"+builder.String())
+}
+
+func Test_ExpandedMultipleHeaderRows(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Service", "Misconfigurations")
+	table.AddHeaders("Service", "Critical", "High")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.SetExpanded(ExpandOn)
+	table.AddRow("ec2", "1", "2")
+	table.Render()
+	assertMultilineEqual(t, `
+┌────────────────────────────────────┐
+│ Record 1                           │
+├──────────────────────────────┬─────┤
+│ Service                      │ ec2 │
+├──────────────────────────────┼─────┤
+│ Misconfigurations / Critical │ 1   │
+├──────────────────────────────┼─────┤
+│ Misconfigurations / High     │ 2   │
+└──────────────────────────────┴─────┘
+`, "\n"+builder.String())
+}
+
+func Test_ExpandedAuto(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B", "C")
+	table.SetExpanded(ExpandAuto)
+	table.AddRow("1", "2", "3")
+	table.SetAvailableWidth(13)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┬───┐
+│ A │ B │ C │
+├───┼───┼───┤
+│ 1 │ 2 │ 3 │
+└───┴───┴───┘
+`, "\n"+builder.String())
+
+	builder.Reset()
+	table.SetAvailableWidth(12)
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────────┐
+│ Record 1 │
+├────┬─────┤
+│ A  │ 1   │
+├────┼─────┤
+│ B  │ 2   │
+├────┼─────┤
+│ C  │ 3   │
+└────┴─────┘
+`, "\n"+builder.String())
+}
@@ -0,0 +1,186 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// Format dictates the output format used when rendering the table
+type Format uint8
+
+const (
+	// FormatTerminal renders the table for display in a terminal (the default)
+	FormatTerminal Format = iota
+	// FormatMarkdown renders the table as a markdown table
+	FormatMarkdown
+	// FormatHTML renders the table as a HTML <table> element
+	FormatHTML
+	// FormatCSV renders the table as CSV, with each spanned cell followed by empty cells to keep columns aligned
+	FormatCSV
+)
+
+// SetFormat sets the output format used when rendering the table. Defaults to FormatTerminal.
+// ANSI codes are removed from cell content when rendering as HTML or CSV.
+func (t *Table) SetFormat(f Format) {
+	t.format = f
+}
+
+// markdown creates a copy of the table using markdown dividers, with pipes in cell content escaped and hyperlinks
+// converted to markdown links
+func (t *Table) markdown() *Table {
+	m := *t
+	m.format = FormatTerminal
+	m.SetTheme(ThemeMarkdown)
+	m.headers = t.escapeMarkdown(t.headers, false)
+	m.data = t.escapeMarkdown(t.data, true)
+	m.footers = t.escapeMarkdown(t.footers, false)
+	// links have already been converted to markdown
+	m.columnLinks = nil
+	m.columns = make([]*Column, len(t.columns))
+	for i, column := range t.columns {
+		unlinked := *column
+		unlinked.link = nil
+		m.columns[i] = &unlinked
+	}
+	m.namedColumns = nil
+	m.rowStyles = nil
+	return &m
+}
+
+func (t *Table) escapeMarkdown(rows [][]string, data bool) [][]string {
+	escape := func(value string) string {
+		return strings.ReplaceAll(value, "|", "\\|")
+	}
+	var output [][]string
+	for r, row := range rows {
+		var escaped []string
+		var relative int
+		for c, value := range row {
+			var url string
+			if data {
+				url = t.columnLink(r, c, relative, value)
+			}
+			// links which aren't allowed are removed by sanitising, so any which are left are converted
+			value = sanitizeContent(value, t.sanitize)
+			relative += t.getColspan(false, false, r, c)
+			if url != "" {
+				// markdown links can't be nested, so any links in the content are reduced to text
+				value = markdownLink(escape(mapLinks(value, false, plainText, nil)), url)
+			} else {
+				value = mapLinks(value, true, escape, func(text string, url string) string {
+					return markdownLink(escape(text), url)
+				})
+			}
+			escaped = append(escaped, value)
+		}
+		output = append(output, escaped)
+	}
+	return output
+}
+
+func (t *Table) renderHTML() {
+	t.print("<table>\n")
+	sections := []struct {
+		tag    string
+		cell   string
+		rows   [][]string
+		header bool
+		footer bool
+	}{
+		{tag: "thead", cell: "th", rows: t.headers, header: true},
+		{tag: "tbody", cell: "td", rows: t.data},
+		{tag: "tfoot", cell: "td", rows: t.footers, footer: true},
+	}
+	for _, section := range sections {
+		if len(section.rows) == 0 {
+			continue
+		}
+		t.print("<" + section.tag + ">\n")
+		for r, row := range section.rows {
+			t.print("<tr>")
+			var relative int
+			for c, value := range row {
+				t.print("<" + section.cell)
+				span := t.getColspan(section.header, section.footer, r, c)
+				if span > 1 {
+					t.print(fmt.Sprintf(` colspan="%d"`, span))
+				}
+				alignment := t.getAlignment(section.header, section.footer, r, c, relative)
+				if style := htmlAlignment(alignment, section.cell == "th"); style != "" {
+					t.print(fmt.Sprintf(` style="text-align: %s"`, style))
+				}
+				t.print(">")
+				var url string
+				if !section.header && !section.footer {
+					url = t.columnLink(r, c, relative, value)
+				}
+				relative += span
+				t.print(t.htmlCell(value, url))
+				t.print("</" + section.cell + ">")
+			}
+			t.print("</tr>\n")
+		}
+		t.print("</" + section.tag + ">\n")
+	}
+	t.print("</table>\n")
+}
+
+func htmlAlignment(a Alignment, header bool) string {
+	switch {
+	case a == AlignRight || a == AlignDecimal:
+		return "right"
+	case a == AlignCenter && !header:
+		return "center"
+	case a == AlignLeft && header:
+		return "left"
+	default:
+		return ""
+	}
+}
+
+// htmlCell converts cell content to HTML, wrapping it in a link to url if it isn't empty
+func (t *Table) htmlCell(value string, url string) string {
+	// links which aren't allowed are removed by sanitising, so any which are left are converted
+	value = sanitizeContent(value, t.sanitize)
+	if url != "" {
+		return htmlLink(mapLinks(value, false, htmlContent, nil), url)
+	}
+	return mapLinks(value, true, htmlContent, func(text string, url string) string {
+		return htmlLink(htmlContent(text), url)
+	})
+}
+
+// htmlLink wraps HTML content in a link, or leaves it as it is if the URL isn't allowed (see allowedURL)
+func htmlLink(content string, url string) string {
+	if !allowedURL(url) {
+		return content
+	}
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), content)
+}
+
+func htmlContent(value string) string {
+	escaped := html.EscapeString(newANSI(value).Strip())
+	return strings.ReplaceAll(escaped, "\n", "<br>")
+}
+
+func (t *Table) renderCSV() {
+	w := csv.NewWriter(t.w)
+	write := func(rows [][]string, header bool, footer bool) {
+		for r, row := range rows {
+			var record []string
+			for c, value := range row {
+				record = append(record, newANSI(sanitizeContent(value, t.sanitize)).Strip())
+				for i := 1; i < t.getColspan(header, footer, r, c); i++ {
+					record = append(record, "")
+				}
+			}
+			_ = w.Write(record)
+		}
+	}
+	write(t.headers, true, false)
+	write(t.data, false, false)
+	write(t.footers, false, true)
+	w.Flush()
+}
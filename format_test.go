@@ -0,0 +1,61 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_FormatMarkdown(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetFormat(FormatMarkdown)
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2|3")
+	table.Render()
+	assertMultilineEqual(t, `
+| A |  B   |
+|---|------|
+| 1 | 2\|3 |
+`, "\n"+builder.String())
+}
+
+func Test_FormatHTML(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetFormat(FormatHTML)
+	table.SetHeaders("Name", "Stats")
+	table.AddHeaders("Name", "Min", "Max")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.SetAlignment(AlignLeft, AlignRight, AlignRight)
+	table.AddRow("\x1b[31m<a>\x1b[0m", "1", "2\n3")
+	table.SetFooters("Total", "1", "5")
+	table.Render()
+	assertMultilineEqual(t, `<table>
+<thead>
+<tr><th>Name</th><th colspan="2">Stats</th></tr>
+<tr><th>Name</th><th>Min</th><th>Max</th></tr>
+</thead>
+<tbody>
+<tr><td>&lt;a&gt;</td><td style="text-align: right">1</td><td style="text-align: right">2<br>3</td></tr>
+</tbody>
+<tfoot>
+<tr><td style="text-align: center">Total</td><td style="text-align: center">1</td><td style="text-align: center">5</td></tr>
+</tfoot>
+</table>
+`, builder.String())
+}
+
+func Test_FormatCSV(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetFormat(FormatCSV)
+	table.SetHeaders("Name", "Stats")
+	table.AddHeaders("Name", "Min", "Max")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.AddRow("\x1b[31mred, bold\x1b[0m", "1", "2")
+	table.Render()
+	assertMultilineEqual(t, `Name,Stats,
+Name,Min,Max
+"red, bold",1,2
+`, builder.String())
+}
@@ -0,0 +1,111 @@
+package table
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// buildFuzzTable creates a table from fuzzed input. Rows are separated by newlines and cells by pipes, and each byte
+// of spans sets the colspan of the next cell in the data.
+func buildFuzzTable(builder *strings.Builder, data string, spans []byte, width int, padding int, maxColumnWidth int, flags uint8) *Table {
+	table := New(builder)
+	table.SetAvailableWidth(width)
+	table.SetPadding(padding)
+	table.SetColumnMaxWidth(maxColumnWidth)
+	table.SetBorders(flags&1 == 0)
+	table.SetRowLines(flags&2 == 0)
+	table.SetAutoMerge(flags&4 != 0)
+	table.SetAutoMergeHeaders(flags&4 != 0)
+
+	var spanIndex int
+	for r, line := range strings.Split(data, "\n") {
+		var cells []string
+		if line != "" {
+			cells = strings.Split(line, "|")
+		}
+		var colspans []int
+		for range cells {
+			span := 1
+			if spanIndex < len(spans) {
+				span = int(spans[spanIndex] % 5)
+				spanIndex++
+			}
+			colspans = append(colspans, span)
+		}
+		switch {
+		case r == 0 && flags&8 != 0:
+			table.AddHeaders(cells...)
+			table.SetHeaderColSpans(0, colspans...)
+		case r == 1 && flags&16 != 0:
+			table.AddFooters(cells...)
+			table.SetFooterColSpans(0, colspans...)
+		default:
+			table.AddRow(cells...)
+			table.SetColSpans(table.RowCount()-1, colspans...)
+		}
+	}
+	return table
+}
+
+func FuzzRender(f *testing.F) {
+	f.Add("A|B|C\n1|2|3\n4|5|6", []byte{}, 80, 1, 60, uint8(8))
+	f.Add("A|B\n1|2|3\n4", []byte{1, 2, 5, 1, 0, 3}, 10, 1, 60, uint8(8|16))
+	f.Add("\n\n", []byte{}, 0, -1, 0, uint8(0))
+	f.Add("this is a long sentence|antidisestablishmentarianism\nx|y", []byte{}, 20, 2, 1, uint8(2))
+	f.Add("a|a\na|a\nb", []byte{2}, 5, 0, 3, uint8(4|8))
+	f.Add("\x1b[31mred\x1b[0m|日本語のテキスト", []byte{}, 12, 1, 60, uint8(0))
+
+	f.Fuzz(func(t *testing.T, data string, spans []byte, width int, padding int, maxColumnWidth int, flags uint8) {
+		if width < 0 || width > 500 || padding > 10 || maxColumnWidth > 200 || len(data) > 500 {
+			t.Skip()
+		}
+		// the width of combining marks and format characters depends on the terminal and the characters around them
+		for _, r := range data {
+			if unicode.In(r, unicode.M, unicode.Cf) {
+				t.Skip()
+			}
+		}
+		builder := &strings.Builder{}
+		table := buildFuzzTable(builder, data, spans, width, padding, maxColumnWidth, flags)
+		table.Render()
+
+		output := strings.TrimSuffix(builder.String(), "\n")
+		if output == "" {
+			return
+		}
+		lines := strings.Split(output, "\n")
+		expected := runewidth.StringWidth(newANSI(lines[0]).Strip())
+		for i, line := range lines {
+			if actual := runewidth.StringWidth(newANSI(line).Strip()); actual != expected {
+				t.Fatalf("line %d has width %d, expected %d:\n%s", i, actual, expected, output)
+			}
+		}
+		if minimum := table.minimumWidth(); minimum <= width && expected > width {
+			t.Fatalf("table is %d wide but could fit within %d (minimum %d):\n%s", expected, width, minimum, output)
+		}
+	})
+}
+
+func FuzzWrapText(f *testing.F) {
+	f.Add("this is a sentence", 5, byte(WrapDefault))
+	f.Add("antidisestablishmentarianism", 1, byte(WrapDefault))
+	f.Add("日本語のテキスト", 3, byte(WrapBalanced))
+	f.Add("\x1b[31mred text\x1b[0m", 0, byte(WrapNoHyphen))
+	f.Add("  /usr/local/bin/some-long-name", 8, byte(WrapPunctuation|WrapPreserveIndent))
+	f.Add("a  b\n\tc", 2, byte(WrapPreformatted))
+
+	f.Fuzz(func(t *testing.T, input string, wrapSize int, wrapping byte) {
+		if wrapSize > 200 || len(input) > 500 {
+			t.Skip()
+		}
+		for _, line := range wrapText(input, wrapSize, Wrapping(wrapping)) {
+			// a single character can't be broken, even if it's wider than the wrap size (after any indent)
+			if line.Len() > wrapSize && line.Len() > 1 && len([]rune(strings.TrimSpace(line.Strip()))) > 1 {
+				t.Fatalf("line %q is wider than %d", line.String(), wrapSize)
+			}
+		}
+	})
+}
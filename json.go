@@ -0,0 +1,258 @@
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JSONOptions configures how JSON data is loaded by LoadJSONWithOptions
+type JSONOptions struct {
+	// Headers sets which keys are loaded, and in which order. By default, every key is loaded in the order first seen.
+	Headers []string
+	// Separator joins the keys of nested objects when they are flattened, e.g. "image.tag". Defaults to "."
+	Separator string
+	// ArraySeparator joins the elements of arrays. Defaults to ", "
+	ArraySeparator string
+	// ArraysAsJSON renders arrays as JSON, rather than joining their elements
+	ArraysAsJSON bool
+	// Null is the value rendered for null values. Defaults to an empty string.
+	Null string
+}
+
+// LoadJSON loads either a JSON array of objects, or a stream of newline-delimited JSON objects (NDJSON), and adds it to
+// the table. The headers are set from the object keys, in the order they are first seen, and nested objects are
+// flattened into columns with dotted keys. Existing rows/footers are retained.
+func (t *Table) LoadJSON(r io.Reader) error {
+	return t.LoadJSONWithOptions(r, JSONOptions{})
+}
+
+// LoadJSONWithOptions is the same as LoadJSON, with control over header order and how values are rendered
+func (t *Table) LoadJSONWithOptions(r io.Reader, opts JSONOptions) error {
+	if opts.Separator == "" {
+		opts.Separator = "."
+	}
+	if opts.ArraySeparator == "" {
+		opts.ArraySeparator = ", "
+	}
+
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var records []*jsonObject
+	for {
+		value, err := readJSONValue(decoder)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		switch v := value.(type) {
+		case *jsonObject:
+			records = append(records, v)
+		case []interface{}:
+			for _, item := range v {
+				record, ok := item.(*jsonObject)
+				if !ok {
+					return fmt.Errorf("expected an array of objects, found %s", jsonType(item))
+				}
+				records = append(records, record)
+			}
+		default:
+			return fmt.Errorf("expected an array of objects or a stream of objects, found %s", jsonType(value))
+		}
+	}
+
+	var flattened []*jsonObject
+	headers := opts.Headers
+	seen := make(map[string]bool)
+	for _, record := range records {
+		flat := newJSONObject()
+		flattenJSON("", record, flat, opts)
+		flattened = append(flattened, flat)
+		if opts.Headers != nil {
+			continue
+		}
+		for _, key := range flat.keys {
+			if !seen[key] {
+				seen[key] = true
+				headers = append(headers, key)
+			}
+		}
+	}
+
+	t.SetHeaders(headers...)
+	for _, record := range flattened {
+		row := make([]string, len(headers))
+		for i, key := range headers {
+			if value, ok := record.values[key]; ok {
+				row[i] = value.(string)
+			}
+		}
+		t.AddRow(row...)
+	}
+
+	return nil
+}
+
+// jsonObject retains the order of an object's keys, which is lost when decoding into a map
+type jsonObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newJSONObject() *jsonObject {
+	return &jsonObject{values: make(map[string]interface{})}
+}
+
+func (o *jsonObject) set(key string, value interface{}) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+func readJSONValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+	switch delim {
+	case '{':
+		object := newJSONObject()
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := keyToken.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", keyToken)
+			}
+			value, err := readJSONValue(decoder)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			object.set(key, value)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return object, nil
+	case '[':
+		array := []interface{}{}
+		for decoder.More() {
+			value, err := readJSONValue(decoder)
+			if err != nil {
+				return nil, unexpectedEOF(err)
+			}
+			array = append(array, value)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return array, nil
+	default:
+		return nil, fmt.Errorf("unexpected %s", delim)
+	}
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// flattenJSON converts an object into a single level object of strings, joining nested keys with the separator
+func flattenJSON(prefix string, object *jsonObject, output *jsonObject, opts JSONOptions) {
+	for _, key := range object.keys {
+		path := key
+		if prefix != "" {
+			path = prefix + opts.Separator + key
+		}
+		if nested, ok := object.values[key].(*jsonObject); ok && len(nested.keys) > 0 {
+			flattenJSON(path, nested, output, opts)
+			continue
+		}
+		output.set(path, formatJSONValue(object.values[key], opts))
+	}
+}
+
+func formatJSONValue(value interface{}, opts JSONOptions) string {
+	switch v := value.(type) {
+	case nil:
+		return opts.Null
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case *jsonObject:
+		return encodeJSONValue(v)
+	case []interface{}:
+		if opts.ArraysAsJSON {
+			return encodeJSONValue(v)
+		}
+		var parts []string
+		for _, item := range v {
+			switch item.(type) {
+			case *jsonObject, []interface{}:
+				parts = append(parts, encodeJSONValue(item))
+			default:
+				parts = append(parts, formatJSONValue(item, opts))
+			}
+		}
+		return strings.Join(parts, opts.ArraySeparator)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// encodeJSONValue converts a decoded value back into compact JSON, retaining the order of object keys
+func encodeJSONValue(value interface{}) string {
+	switch v := value.(type) {
+	case *jsonObject:
+		var parts []string
+		for _, key := range v.keys {
+			parts = append(parts, encodeJSONValue(key)+":"+encodeJSONValue(v.values[key]))
+		}
+		return "{" + strings.Join(parts, ",") + "}"
+	case []interface{}:
+		var parts []string
+		for _, item := range v {
+			parts = append(parts, encodeJSONValue(item))
+		}
+		return "[" + strings.Join(parts, ",") + "]"
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case *jsonObject:
+		return "object"
+	case []interface{}:
+		return "array"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
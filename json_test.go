@@ -0,0 +1,64 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LoadJSONArray(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	require.NoError(t, table.LoadJSON(strings.NewReader(`[
+	{"name": "nginx", "image": {"repo": "nginx", "tag": "1.25"}, "ports": [80, 443]},
+	{"name": "redis", "image": {"repo": "redis"}, "replicas": 3, "debug": false, "owner": null}
+]`)))
+	table.SetRowLines(false)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────┬────────────┬───────────┬─────────┬──────────┬───────┬───────┐
+│ name  │ image.repo │ image.tag │  ports  │ replicas │ debug │ owner │
+├───────┼────────────┼───────────┼─────────┼──────────┼───────┼───────┤
+│ nginx │ nginx      │ 1.25      │ 80, 443 │          │       │       │
+│ redis │ redis      │           │         │ 3        │ false │       │
+└───────┴────────────┴───────────┴─────────┴──────────┴───────┴───────┘
+`, "\n"+builder.String())
+}
+
+func Test_LoadJSONStreamWithOptions(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	require.NoError(t, table.LoadJSONWithOptions(strings.NewReader(`{"id": 1, "tags": ["a", "b"], "meta": {"x": {"y": 1}}}
+{"id": 2, "tags": [], "meta": null}
+`), JSONOptions{
+		Headers:      []string{"id", "meta/x/y", "tags"},
+		Separator:    "/",
+		ArraysAsJSON: true,
+		Null:         "-",
+	}))
+	table.SetRowLines(false)
+	table.Render()
+	assertMultilineEqual(t, `
+┌────┬──────────┬───────────┐
+│ id │ meta/x/y │   tags    │
+├────┼──────────┼───────────┤
+│ 1  │ 1        │ ["a","b"] │
+│ 2  │          │ []        │
+└────┴──────────┴───────────┘
+`, "\n"+builder.String())
+}
+
+func Test_LoadJSONErrors(t *testing.T) {
+	for _, input := range []string{
+		`[1, 2]`,
+		`"hello"`,
+		`[{"a": 1}`,
+		`{"a": }`,
+	} {
+		t.Run(input, func(t *testing.T) {
+			assert.Error(t, New(&strings.Builder{}).LoadJSON(strings.NewReader(input)))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package table
+
+import "strings"
+
+// Layout describes a rendered table, for tools which display it themselves, such as the viewer package
+type Layout struct {
+	// Lines are the rendered lines of the table, without line endings
+	Lines []string
+	// HeaderLines is the number of lines at the top of the table before the first row of data, which includes the
+	// top border, headers and the line below them
+	HeaderLines int
+	// FooterLines is the number of lines at the bottom of the table after the last row of data
+	FooterLines int
+	// Rows are the lines each row of data occupies, not including the lines between rows
+	Rows []LineRange
+	// FrozenWidth is the width of the left border and frozen columns (see SetFrozenColumns), including the divider
+	// after them, which stay in view when scrolling horizontally. It is zero when there are no frozen columns.
+	FrozenWidth int
+}
+
+// LineRange is a range of lines, from Start up to but not including End
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Layout renders the table as it would be rendered in the terminal, recording where the headers, rows and footers
+// are. Paging, splitting, expanded mode and live mode are ignored.
+func (t *Table) Layout() Layout {
+	var layout Layout
+	if t.hasNoCells() {
+		return layout
+	}
+	if t.rowNumbers {
+		return t.numbered().Layout()
+	}
+
+	defer t.resolveNamedColumns()()
+
+	var buffer strings.Builder
+	c := *t
+	c.w = &buffer
+	c.formatData()
+	if frozen := c.frozen(len(c.columnWidths())); frozen > 0 {
+		// split cells which span across the edge of the frozen columns, so the edge is always a divider
+		c = *t.selectColumns([]columnRange{{start: 0, end: frozen}, {start: frozen, end: t.findMaxCols()}})
+		c.w = &buffer
+		c.formatData()
+		layout.FrozenWidth = c.frozenWidth(frozen)
+	}
+
+	// count lines as they are written
+	var lines, offset int
+	count := func() int {
+		lines += strings.Count(buffer.String()[offset:], "\n")
+		offset = buffer.Len()
+		return lines
+	}
+
+	var lastRow iRow
+	firstData := -1
+	lastData := -1
+	for _, row := range c.formatted {
+		c.renderLineAbove(row, lastRow)
+		start := count()
+		c.renderRowContent(row)
+		end := count()
+		if !row.header && !row.footer {
+			if firstData < 0 {
+				firstData = start
+			}
+			lastData = end
+			layout.Rows = append(layout.Rows, LineRange{Start: start, End: end})
+		}
+		c.renderLineBelow(row)
+		lastRow = row
+	}
+	total := count()
+
+	layout.Lines = strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if firstData < 0 {
+		layout.HeaderLines = total
+		return layout
+	}
+	layout.HeaderLines = firstData
+	layout.FooterLines = total - lastData
+	return layout
+}
+
+// frozenWidth calculates the width of the left border and the given number of columns, including the divider after them
+func (t *Table) frozenWidth(columns int) int {
+	dw := t.dividerWidth()
+	var width int
+	if t.borders.Left {
+		width += dw
+	}
+	for _, w := range t.columnWidths()[:columns] {
+		width += w + (t.padding * 2) + dw
+	}
+	return width
+}
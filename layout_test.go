@@ -0,0 +1,87 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Layout(t *testing.T) {
+	table := New(&strings.Builder{})
+	table.SetHeaders("Name", "Notes")
+	table.AddRow("a", "one\ntwo")
+	table.AddRow("b", "three")
+	table.SetFooters("Total", "2")
+	layout := table.Layout()
+	assert.Equal(t, []string{
+		"┌───────┬───────┐",
+		"│ Name  │ Notes │",
+		"├───────┼───────┤",
+		"│ a     │ one   │",
+		"│       │ two   │",
+		"├───────┼───────┤",
+		"│ b     │ three │",
+		"├───────┼───────┤",
+		"│ Total │   2   │",
+		"└───────┴───────┘",
+	}, layout.Lines)
+	assert.Equal(t, 3, layout.HeaderLines)
+	assert.Equal(t, 3, layout.FooterLines)
+	assert.Equal(t, []LineRange{{Start: 3, End: 5}, {Start: 6, End: 7}}, layout.Rows)
+}
+
+func Test_LayoutFrozenColumns(t *testing.T) {
+	table := New(&strings.Builder{})
+	table.SetHeaders("Resource", "Findings")
+	table.AddHeaders("Kind", "Name", "Count")
+	table.SetHeaderColSpans(0, 2, 1)
+	table.AddRow("Pod", "web", "3")
+	table.SetFrozenColumns(1)
+	layout := table.Layout()
+	assert.Equal(t, []string{
+		"┌──────────┬──────────┬──────────┐",
+		"│ Resource │ Resource │ Findings │",
+		"├──────────┼──────────┼──────────┤",
+		"│   Kind   │   Name   │  Count   │",
+		"├──────────┼──────────┼──────────┤",
+		"│ Pod      │ web      │ 3        │",
+		"└──────────┴──────────┴──────────┘",
+	}, layout.Lines)
+	assert.Equal(t, 12, layout.FrozenWidth)
+}
+
+func Test_LayoutEmpty(t *testing.T) {
+	table := New(&strings.Builder{})
+	layout := table.Layout()
+	assert.Equal(t, Layout{}, layout)
+}
+
+func Test_Clone(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B")
+	table.SetAlignment(AlignLeft, AlignRight)
+	table.AddRow("1", "2")
+	table.AddRow("3")
+	table.SetColSpans(1, 2)
+
+	clone := table.Clone()
+	clone.SetCell(0, 0, "changed")
+	clone.SetColSpans(1, 1)
+	clone.SetAlignment(AlignRight)
+	clone.Column("A").SetAlignment(AlignCenter)
+
+	assert.Equal(t, "1", table.Cell(0, 0))
+	assert.Equal(t, "changed", clone.Cell(0, 0))
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 1 │ 2 │
+├───┴───┤
+│ 3     │
+└───────┘
+`, "\n"+builder.String())
+}
@@ -0,0 +1,153 @@
+package table
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// linkParam marks the hyperlinks created by Link, so they can be told apart from hyperlinks in cell data. It includes
+// a random token, so it can't be forged by data from elsewhere, and it is removed when the table is rendered.
+var linkParam = newLinkParam()
+
+func newLinkParam() string {
+	token := make([]byte, 16)
+	_, _ = rand.Read(token)
+	return "table-link=" + hex.EncodeToString(token)
+}
+
+// Link wraps text in an OSC 8 hyperlink to the given URL, which most modern terminals render as a clickable link.
+// Links created by Link are rendered unless escape sequences are stripped with SanitizeStrip, whereas hyperlinks
+// which are already in cell data are reduced to their text unless SanitizeStylesAndLinks (or SanitizeNone) is set.
+func Link(text string, url string) string {
+	return "\x1b]8;" + linkParam + ";" + safeURL(url) + "\x1b\\" + text + "\x1b]8;" + linkParam + ";\x1b\\"
+}
+
+func hyperlink(url string) string {
+	return "\x1b]8;;" + url + "\x1b\\"
+}
+
+// isLink checks whether an OSC 8 sequence was created by Link
+func isLink(sequence string) bool {
+	return strings.HasPrefix(sequence, "\x1b]8;"+linkParam+";")
+}
+
+// unmarkLinks removes the marks from hyperlinks created by Link
+func unmarkLinks(value string) string {
+	if !strings.Contains(value, linkParam) {
+		return value
+	}
+	return strings.ReplaceAll(value, "\x1b]8;"+linkParam+";", "\x1b]8;;")
+}
+
+// safeURL removes control characters from a URL, which is written inside an escape sequence and so must not be able
+// to escape it
+func safeURL(url string) string {
+	return strings.Map(func(r rune) rune {
+		if isControl(r) {
+			return -1
+		}
+		return r
+	}, url)
+}
+
+// SetColumnLinks sets a function which returns the URL each data cell in the given column should link to, or an empty
+// string for no link. Links are rendered as OSC 8 hyperlinks in the terminal, <a> elements in HTML and [text](url) in
+// markdown, and as plain text when escape sequences are stripped with SanitizeStrip.
+func (t *Table) SetColumnLinks(column int, url func(value string) string) {
+	if t.columnLinks == nil {
+		t.columnLinks = make(map[int]func(string) string)
+	}
+	t.columnLinks[column] = url
+}
+
+// columnLink returns the URL for a data cell, if the column has links
+func (t *Table) columnLink(row int, column int, relative int, value string) string {
+	fn, ok := t.columnLinks[t.sourceCell(false, false, row, column)]
+	if named := t.namedColumn(relative); named != nil && named.link != nil {
+		fn, ok = named.link, true
+	}
+	if !ok || t.sanitize == SanitizeStrip {
+		return ""
+	}
+	return safeURL(fn(newANSI(value).Strip()))
+}
+
+// mapLinks converts the hyperlinks in a value using the link function, and the rest of the value using the plain
+// function. When links aren't allowed, the text of each link is treated as plain.
+func mapLinks(value string, allowed bool, plain func(string) string, link func(text string, url string) string) string {
+	var output strings.Builder
+	var text strings.Builder
+	var url string
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+		if url != "" && allowed {
+			output.WriteString(link(text.String(), url))
+		} else {
+			output.WriteString(plain(text.String()))
+		}
+		text.Reset()
+	}
+	for _, segment := range newANSI(value) {
+		for i := 0; i < len(segment.style); {
+			length, kind := escapeLength(segment.style[i:])
+			if length == 0 {
+				break
+			}
+			sequence := segment.style[i : i+length]
+			i += length
+			if kind != escapeOSC || !strings.HasPrefix(sequence, "\x1b]8;") {
+				text.WriteString(sequence)
+				continue
+			}
+			flush()
+			url = hyperlinkURL(sequence)
+		}
+		text.WriteString(segment.value)
+	}
+	flush()
+	return output.String()
+}
+
+// hyperlinkURL extracts the URL from an OSC 8 sequence, which is empty where the sequence closes a link
+func hyperlinkURL(sequence string) string {
+	body := strings.TrimSuffix(strings.TrimSuffix(sequence, "\x1b\\"), "\x07")
+	parts := strings.SplitN(strings.TrimPrefix(body, "\x1b]8;"), ";", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+func plainText(text string) string {
+	return text
+}
+
+// markdownLink converts text to a markdown link, or leaves it as text if the URL isn't allowed (see allowedURL)
+func markdownLink(text string, url string) string {
+	text = strings.NewReplacer("[", "\\[", "]", "\\]").Replace(text)
+	if !allowedURL(url) {
+		return text
+	}
+	url = strings.NewReplacer(")", "%29", "|", "%7C", " ", "%20").Replace(url)
+	return fmt.Sprintf("[%s](%s)", text, url)
+}
+
+// allowedURL checks whether a URL can be written as a link in HTML or markdown, which is only the case for http,
+// https and mailto URLs, as others such as javascript: URLs can run code where the output is viewed
+func allowedURL(link string) bool {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,197 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Link(t *testing.T) {
+	link := Link("text", "https://example.com/\x1b")
+	assert.Contains(t, link, linkParam)
+	assert.Equal(t, "\x1b]8;;https://example.com/\x1b\\text\x1b]8;;\x1b\\", unmarkLinks(link))
+}
+
+// dataLink is a hyperlink which wasn't created by Link, as if it came from cell data
+func dataLink(text string, url string) string {
+	return hyperlink(url) + text + closeHyperlink
+}
+
+func Test_ColumnLinks(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "ID")
+	table.SetColumnLinks(1, func(value string) string {
+		if value == "" {
+			return ""
+		}
+		return "https://example.com/\x1b" + value
+	})
+	table.AddRow("a", "1")
+	table.AddRow("b", "")
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────┬────┐
+│ Name │ ID │
+├──────┼────┤
+│ a    │ `+dataLink("1", "https://example.com/1")+`  │
+├──────┼────┤
+│ b    │    │
+└──────┴────┘
+`, "\n"+builder.String())
+}
+
+func Test_ColumnLinksStripped(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetSanitize(SanitizeStrip)
+	table.SetColumnLinks(0, func(value string) string {
+		return "https://example.com"
+	})
+	table.AddRow("a")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┐
+│ a │
+└───┘
+`, "\n"+builder.String())
+}
+
+func Test_ContentLinks(t *testing.T) {
+	link := dataLink("docs", "https://example.com")
+	tests := []struct {
+		mode     SanitizeMode
+		content  string
+		expected string
+	}{
+		{mode: SanitizeStyles, content: link, expected: "docs"},
+		{mode: SanitizeStrip, content: link, expected: "docs"},
+		{mode: SanitizeStylesAndLinks, content: link, expected: link},
+		{mode: SanitizeNone, content: link, expected: link},
+		{mode: SanitizeStyles, content: Link("docs", "https://example.com"), expected: link},
+		{mode: SanitizeStrip, content: Link("docs", "https://example.com"), expected: "docs"},
+		{mode: SanitizeStylesAndLinks, content: Link("docs", "https://example.com"), expected: link},
+		{mode: SanitizeNone, content: Link("docs", "https://example.com"), expected: link},
+	}
+	for _, test := range tests {
+		builder := &strings.Builder{}
+		table := New(builder)
+		table.SetSanitize(test.mode)
+		table.AddRow(test.content)
+		table.Render()
+		assertMultilineEqual(t, `
+┌──────┐
+│ `+test.expected+` │
+└──────┘
+`, "\n"+builder.String())
+	}
+}
+
+func Test_ContentLinksOtherOSCStillVisible(t *testing.T) {
+	assert.Equal(t, "␛]0;title␇x", sanitizeContent("\x1b]0;title\x07x", SanitizeStylesAndLinks))
+}
+
+func Test_LinksMarkdown(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetFormat(FormatMarkdown)
+	table.SetHeaders("Name", "Docs")
+	table.SetColumnLinks(0, func(value string) string {
+		return "https://example.com/" + value
+	})
+	table.AddRow("a|b", "see "+Link("here", "https://example.com/(x)"))
+	table.Render()
+	assertMultilineEqual(t, `
+|               Name                |                 Docs                  |
+|-----------------------------------|---------------------------------------|
+| [a\|b](https://example.com/a%7Cb) | see [here](https://example.com/(x%29) |
+`, "\n"+builder.String())
+}
+
+func Test_LinksHTML(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetFormat(FormatHTML)
+	table.SetHeaders("Name", "Docs")
+	table.SetColumnLinks(0, func(value string) string {
+		return "https://example.com/?a=1&b=" + value
+	})
+	table.AddRow("<a>", "see "+Link("here", "https://example.com/\"x\""))
+	table.Render()
+	assertMultilineEqual(t, `<table>
+<thead>
+<tr><th>Name</th><th>Docs</th></tr>
+</thead>
+<tbody>
+<tr><td><a href="https://example.com/?a=1&amp;b=&lt;a&gt;">&lt;a&gt;</a></td><td>see <a href="https://example.com/&#34;x&#34;">here</a></td></tr>
+</tbody>
+</table>
+`, builder.String())
+}
+
+func Test_LinksCSV(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetFormat(FormatCSV)
+	table.SetSanitize(SanitizeStylesAndLinks)
+	table.SetColumnLinks(0, func(value string) string {
+		return "https://example.com"
+	})
+	table.AddRow("a", Link("b", "https://example.com"))
+	table.Render()
+	assert.Equal(t, "a,b\n", builder.String())
+}
+
+func Test_LinksFromDataStrippedInOutputFormats(t *testing.T) {
+	for _, format := range []Format{FormatMarkdown, FormatHTML, FormatCSV} {
+		builder := &strings.Builder{}
+		table := New(builder)
+		table.SetFormat(format)
+		table.AddRow("see "+dataLink("here", "https://evil.example.com"), Link("docs", "https://example.com"))
+		table.Render()
+		assert.NotContains(t, builder.String(), "evil.example.com")
+		assert.NotContains(t, builder.String(), linkParam)
+		if format != FormatCSV {
+			assert.Contains(t, builder.String(), "https://example.com")
+		}
+	}
+}
+
+func Test_LinksUnsafeSchemes(t *testing.T) {
+	for _, mode := range []SanitizeMode{SanitizeStylesAndLinks, SanitizeNone} {
+		for _, format := range []Format{FormatMarkdown, FormatHTML} {
+			builder := &strings.Builder{}
+			table := New(builder)
+			table.SetFormat(format)
+			table.SetSanitize(mode)
+			table.SetColumnLinks(1, func(value string) string {
+				return "JavaScript:alert(" + value + ")"
+			})
+			table.AddRow(dataLink("click", "javascript:alert(1)"), "x", Link("mail", "mailto:a@example.com"))
+			table.Render()
+			assert.NotContains(t, strings.ToLower(builder.String()), "javascript")
+			assert.Contains(t, builder.String(), "click")
+			assert.Contains(t, builder.String(), "mailto:a@example.com")
+		}
+	}
+}
+
+func Test_AllowedURL(t *testing.T) {
+	tests := map[string]bool{
+		"https://example.com":  true,
+		"HTTP://example.com":   true,
+		"mailto:a@example.com": true,
+		"javascript:alert(1)":  false,
+		"data:text/html,x":     false,
+		"/relative":            false,
+		" javascript:x":        false,
+	}
+	for link, expected := range tests {
+		assert.Equal(t, expected, allowedURL(link), link)
+	}
+}
+
+func Test_MarkdownLinkEscapesBrackets(t *testing.T) {
+	assert.Equal(t, `[a\]\[b](https://example.com)`, markdownLink("a][b", "https://example.com"))
+}
@@ -0,0 +1,76 @@
+package table
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// SetLive sets whether each call to Render redraws the previously rendered table in place, for views which are
+// refreshed periodically. Only lines which have changed are redrawn. When the writer isn't a terminal, or either the
+// previous or the new table is too tall for the terminal, the table is rendered again in full instead. Nothing else should be
+// written to the terminal between renders; call SetLive again to start afresh below any other output.
+func (t *Table) SetLive(enabled bool) {
+	t.live = enabled
+	t.liveLines = nil
+}
+
+// terminalHeight returns the height of the terminal a writer writes to, if it is one
+var terminalHeight = func(w io.Writer) (int, bool) {
+	f, ok := w.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0, false
+	}
+	_, height, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0, false
+	}
+	return height, true
+}
+
+// renderLive renders the table, then redraws it over the previous render
+func (t *Table) renderLive() {
+	var buffer strings.Builder
+	// rendering updates state held by the table, so a copy is rendered rather than one sharing its maps and slices
+	c := t.Clone()
+	c.w = &buffer
+	c.live = false
+	c.Render()
+
+	lines := strings.SplitAfter(buffer.String(), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	previous := t.liveLines
+	t.liveLines = lines
+
+	height, ok := terminalHeight(t.w)
+	// a table which doesn't fit would scroll the terminal, leaving the cursor somewhere other than the start of it
+	if !ok || len(previous) == 0 || len(previous) >= height || len(lines) >= height {
+		t.print(buffer.String())
+		return
+	}
+
+	// move to the start of the previous table, then overwrite the lines which have changed
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("\r\x1b[%dA", len(previous)))
+	for i, line := range lines {
+		if i < len(previous) && previous[i] == line {
+			output.WriteString("\n")
+			continue
+		}
+		output.WriteString("\x1b[2K" + line)
+		if !strings.HasSuffix(line, "\n") {
+			output.WriteString("\n")
+		}
+	}
+	// clear any lines left over from a taller table
+	if extra := len(previous) - len(lines); extra > 0 {
+		output.WriteString(strings.Repeat("\x1b[2K\n", extra))
+		output.WriteString(fmt.Sprintf("\x1b[%dA", extra))
+	}
+	t.print(output.String())
+}
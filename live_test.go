@@ -0,0 +1,94 @@
+package table
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeTerminal(t *testing.T, height int) {
+	original := terminalHeight
+	terminalHeight = func(io.Writer) (int, bool) {
+		return height, true
+	}
+	t.Cleanup(func() {
+		terminalHeight = original
+	})
+}
+
+func Test_LiveRedrawsChangedLines(t *testing.T) {
+	fakeTerminal(t, 24)
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetLive(true)
+	table.SetHeaders("Job", "State")
+	table.AddRow("build", "running")
+	table.AddRow("test", "waiting")
+	table.Render()
+	assert.Equal(t, `┌───────┬─────────┐
+│  Job  │  State  │
+├───────┼─────────┤
+│ build │ running │
+├───────┼─────────┤
+│ test  │ waiting │
+└───────┴─────────┘
+`, builder.String())
+
+	builder.Reset()
+	table.SetCell(0, 1, "done")
+	table.Render()
+	assert.Equal(t, "\r\x1b[7A\n\n\n\x1b[2K│ build │ done    │\n\n\n\n", builder.String())
+}
+
+func Test_LiveClearsShorterTable(t *testing.T) {
+	fakeTerminal(t, 24)
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetLive(true)
+	table.AddRow("a")
+	table.AddRow("b")
+	table.Render()
+
+	builder.Reset()
+	table.DeleteRow(1)
+	table.Render()
+	assert.Equal(t, "\r\x1b[5A\n\n\x1b[2K└───┘\n\x1b[2K\n\x1b[2K\n\x1b[2A", builder.String())
+}
+
+func Test_LiveFallsBackWhenTooTall(t *testing.T) {
+	fakeTerminal(t, 3)
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetLive(true)
+	table.AddRow("a")
+	table.Render()
+	table.Render()
+	assert.Equal(t, strings.Repeat("┌───┐\n│ a │\n└───┘\n", 2), builder.String())
+}
+
+func Test_LiveNotTerminal(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetLive(true)
+	table.AddRow("a")
+	table.Render()
+	table.Render()
+	assert.Equal(t, strings.Repeat("┌───┐\n│ a │\n└───┘\n", 2), builder.String())
+}
+
+func Test_LiveFallsBackWhenGrowingTooTall(t *testing.T) {
+	fakeTerminal(t, 6)
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetLive(true)
+	table.AddRow("a")
+	table.Render()
+
+	builder.Reset()
+	table.AddRow("b")
+	table.AddRow("c")
+	table.Render()
+	assert.Equal(t, "┌───┐\n│ a │\n├───┤\n│ b │\n├───┤\n│ c │\n└───┘\n", builder.String())
+}
@@ -0,0 +1,53 @@
+package table
+
+import "strconv"
+
+// SetRowNumbers sets whether to add a right-aligned column of row numbers to the left of the table when it is
+// rendered, counting from startAt with the given header. Numbers follow the order rows are rendered in, so they stay
+// sequential after rows are sorted or removed. The column isn't included in CSV output unless SetExportRowNumbers is
+// enabled.
+func (t *Table) SetRowNumbers(enabled bool, startAt int, header string) {
+	t.rowNumbers = enabled
+	t.rowNumberStart = startAt
+	t.rowNumberHeader = header
+}
+
+// SetExportRowNumbers sets whether row numbers (see SetRowNumbers) are included in machine-readable output, such as
+// CSV. Defaults to false.
+func (t *Table) SetExportRowNumbers(enabled bool) {
+	t.exportRowNumbers = enabled
+}
+
+// showRowNumbers checks whether a row number column should be added for the current format
+func (t *Table) showRowNumbers() bool {
+	return t.rowNumbers && (t.format != FormatCSV || t.exportRowNumbers)
+}
+
+// numbered creates a copy of the table with a column of row numbers inserted before the first column
+func (t *Table) numbered() *Table {
+	n := t.Clone()
+	n.rowNumbers = false
+
+	numbers := make([]string, len(t.data))
+	for r := range numbers {
+		numbers[r] = strconv.Itoa(t.rowNumberStart + r)
+	}
+	n.InsertColumn(0, t.rowNumberHeader, numbers...)
+
+	if n.autoMergeHeaders {
+		// repeat the header in each header row, so it is merged into a single cell
+		for r := range n.headers {
+			n.headers[r][0] = t.rowNumberHeader
+		}
+	}
+	if len(n.alignments) == 0 {
+		n.alignments = []Alignment{AlignRight}
+	} else {
+		n.alignments[0] = AlignRight
+	}
+	// row numbers are kept in view along with any frozen columns
+	if n.frozenColumns > 0 {
+		n.frozenColumns++
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RowNumbers(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "Score")
+	table.AddRow("alpha", "7")
+	table.AddRow("beta", "10")
+	table.SetFooters("Total", "17")
+	table.SetRowNumbers(true, 1, "#")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───────┬───────┐
+│ # │ Name  │ Score │
+├───┼───────┼───────┤
+│ 1 │ alpha │ 7     │
+├───┼───────┼───────┤
+│ 2 │ beta  │ 10    │
+├───┼───────┼───────┤
+│   │ Total │  17   │
+└───┴───────┴───────┘
+`, "\n"+builder.String())
+}
+
+func Test_RowNumbersWithColSpans(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Service", "Findings")
+	table.AddHeaders("Service", "High", "Low")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.SetAutoMergeHeaders(true)
+	table.AddRow("ec2", "1", "2")
+	table.AddRow("s3", "0", "4")
+	table.SetRowNumbers(true, 0, "#")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬─────────┬────────────┐
+│ # │ Service │  Findings  │
+│   │         ├──────┬─────┤
+│   │         │ High │ Low │
+├───┼─────────┼──────┼─────┤
+│ 0 │ ec2     │ 1    │ 2   │
+├───┼─────────┼──────┼─────┤
+│ 1 │ s3      │ 0    │ 4   │
+└───┴─────────┴──────┴─────┘
+`, "\n"+builder.String())
+}
+
+func Test_RowNumbersCSV(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name")
+	table.AddRow("alpha")
+	table.SetRowNumbers(true, 1, "#")
+	table.SetFormat(FormatCSV)
+	table.Render()
+	table.SetExportRowNumbers(true)
+	table.Render()
+	assertMultilineEqual(t, `
+Name
+alpha
+#,Name
+1,alpha
+`, "\n"+builder.String())
+}
+
+func Test_RowNumbersPaged(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name")
+	table.AddRow("alpha")
+	table.AddRow("beta")
+	table.AddRow("gamma")
+	table.SetRowLines(false)
+	table.SetPageSize(2)
+	table.SetRowNumbers(true, 1, "#")
+	assert.Equal(t, 2, table.PageCount())
+	require.NoError(t, table.RenderPage(1))
+	assertMultilineEqual(t, `
+┌───┬───────┐
+│ # │ Name  │
+├───┼───────┤
+│ 3 │ gamma │
+└───┴───────┘
+`, "\n"+builder.String())
+}
@@ -0,0 +1,186 @@
+package table
+
+import (
+	"fmt"
+)
+
+// SetPageSize sets the maximum number of rows rendered on each page. Header rows are repeated on every page.
+// A size of 0 (the default) disables pagination.
+func (t *Table) SetPageSize(rows int) {
+	t.pageSize = rows
+}
+
+// SetPageHeight sets the maximum number of lines rendered on each page, including headers, footers and borders.
+// Each page always contains at least one row, even if that row alone exceeds the height.
+// A height of 0 (the default) disables height-based pagination.
+func (t *Table) SetPageHeight(lines int) {
+	t.pageHeight = lines
+}
+
+// SetPageFooters sets whether footer rows are repeated on every page, rather than only on the last page
+func (t *Table) SetPageFooters(enabled bool) {
+	t.pageFooters = enabled
+}
+
+// SetPageSeparator sets the string written between pages. Defaults to a single newline.
+func (t *Table) SetPageSeparator(separator string) {
+	t.pageSeparator = separator
+}
+
+// PageCount returns the number of pages the table will be rendered across
+func (t *Table) PageCount() int {
+	if t.hasNoCells() {
+		return 0
+	}
+	if t.showRowNumbers() {
+		return t.numbered().PageCount()
+	}
+	if t.shouldExpand() {
+		return t.expanded().PageCount()
+	}
+	defer t.resolveNamedColumns()()
+	t.formatData()
+	return len(t.paginate())
+}
+
+// RenderPage writes a single page of the table to the provided io.Writer. Pages are indexed from 0.
+func (t *Table) RenderPage(n int) error {
+	if t.hasNoCells() {
+		return fmt.Errorf("page %d out of range: table is empty", n)
+	}
+	if t.showRowNumbers() {
+		return t.numbered().RenderPage(n)
+	}
+	if t.shouldExpand() {
+		return t.expanded().RenderPage(n)
+	}
+	defer t.resolveNamedColumns()()
+	t.formatData()
+	pages := t.paginate()
+	if n < 0 || n >= len(pages) {
+		return fmt.Errorf("page %d out of range: table has %d page(s)", n, len(pages))
+	}
+	t.renderRowSet(t.pageRows(pages[n], n == len(pages)-1))
+	return nil
+}
+
+func (t *Table) isPaged() bool {
+	return t.pageSize > 0 || t.pageHeight > 0
+}
+
+type pageRange struct {
+	start int
+	end   int
+}
+
+// split formatted rows into their header, content and footer sections
+func (t *Table) sections() (header []iRow, body []iRow, footer []iRow) {
+	for _, row := range t.formatted {
+		switch {
+		case row.header:
+			header = append(header, row)
+		case row.footer:
+			footer = append(footer, row)
+		default:
+			body = append(body, row)
+		}
+	}
+	return header, body, footer
+}
+
+// paginate works out which content rows belong on each page
+func (t *Table) paginate() []pageRange {
+	header, body, footer := t.sections()
+	if len(body) == 0 {
+		return []pageRange{{}}
+	}
+
+	// always leave room for footers, as we don't know yet whether a page will be the last
+	fixed := t.countLines(header, iRow{}, true) + t.countLines(footer, iRow{}, false)
+	if t.borders.Bottom {
+		fixed++
+	}
+	var above iRow
+	if len(header) > 0 {
+		above = header[len(header)-1]
+	}
+
+	var pages []pageRange
+	for start := 0; start < len(body); {
+		lines := fixed + t.countLines(body[start:start+1], above, len(header) == 0)
+		end := start + 1
+		for end < len(body) {
+			lines += t.countLines(body[end:end+1], body[end-1], false)
+			if !t.pageFits(end+1-start, lines) {
+				break
+			}
+			end++
+		}
+		pages = append(pages, pageRange{start: start, end: end})
+		start = end
+	}
+	return pages
+}
+
+func (t *Table) pageFits(rows int, lines int) bool {
+	if t.pageSize > 0 && rows > t.pageSize {
+		return false
+	}
+	return t.pageHeight <= 0 || lines <= t.pageHeight
+}
+
+// pageRows builds the rows for a single page, adjusting borders and merged cells at the page boundaries
+func (t *Table) pageRows(page pageRange, last bool) []iRow {
+	header, body, footer := t.sections()
+
+	var rows []iRow
+	rows = append(rows, header...)
+	rows = append(rows, body[page.start:page.end]...)
+	if last || t.pageFooters {
+		rows = append(rows, footer...)
+	}
+
+	for i, row := range rows {
+		row.cols = append([]iCol(nil), row.cols...)
+		row.first = i == 0
+		row.last = i == len(rows)-1
+		if !row.header && !row.footer {
+			for c := range row.cols {
+				// merged cells can't continue from the previous page or onto the next one
+				if i == len(header) {
+					row.cols[c].mergeAbove = false
+				}
+				if i == len(header)+(page.end-page.start)-1 {
+					row.cols[c].mergeBelow = false
+				}
+			}
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+func (t *Table) renderPages() {
+	pages := t.paginate()
+	for i, page := range pages {
+		if i > 0 {
+			t.print(t.pageSeparator)
+		}
+		t.renderRowSet(t.pageRows(page, i == len(pages)-1))
+	}
+}
+
+// countLines calculates the number of lines required to render the given rows after prev, including the lines
+// above them but not the bottom border. The first row is treated as the first of the page if first is set.
+func (t *Table) countLines(rows []iRow, prev iRow, first bool) int {
+	var lines int
+	for i, row := range rows {
+		row.first = first && i == 0
+		if t.hasLineAbove(row, prev) {
+			lines++
+		}
+		lines += row.height
+		prev = row
+	}
+	return lines
+}
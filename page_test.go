@@ -0,0 +1,174 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PageSize(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B", "C")
+	table.SetRowLines(false)
+	table.SetPageSize(2)
+	table.AddRow("1", "2", "3")
+	table.AddRow("4", "5", "6")
+	table.AddRow("7", "8", "9")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┬───┐
+│ A │ B │ C │
+├───┼───┼───┤
+│ 1 │ 2 │ 3 │
+│ 4 │ 5 │ 6 │
+└───┴───┴───┘
+
+┌───┬───┬───┐
+│ A │ B │ C │
+├───┼───┼───┤
+│ 7 │ 8 │ 9 │
+└───┴───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_PageFooters(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B")
+	table.SetFooters("X", "Y")
+	table.SetRowLines(false)
+	table.SetPageSize(1)
+	table.SetPageSeparator("--\n")
+	table.AddRow("1", "2")
+	table.AddRow("3", "4")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 1 │ 2 │
+└───┴───┘
+--
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 3 │ 4 │
+├───┼───┤
+│ X │ Y │
+└───┴───┘
+`, "\n"+builder.String())
+
+	builder.Reset()
+	table.SetPageFooters(true)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 1 │ 2 │
+├───┼───┤
+│ X │ Y │
+└───┴───┘
+--
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 3 │ 4 │
+├───┼───┤
+│ X │ Y │
+└───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_PageHeight(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B")
+	table.SetPageHeight(8)
+	table.AddRow("1", "2")
+	table.AddRow("3", "4")
+	table.AddRow("5", "6\n7")
+	assert.Equal(t, 2, table.PageCount())
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 1 │ 2 │
+├───┼───┤
+│ 3 │ 4 │
+└───┴───┘
+
+┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 5 │ 6 │
+│   │ 7 │
+└───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_RenderPage(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Fruit", "Colour")
+	table.SetAutoMerge(true)
+	table.SetPageSize(2)
+	table.AddRow("Apple", "Red")
+	table.AddRow("Cherry", "Red")
+	table.AddRow("Strawberry", "Red")
+	assert.Equal(t, 2, table.PageCount())
+	require.NoError(t, table.RenderPage(1))
+	assertMultilineEqual(t, `
+┌────────────┬────────┐
+│   Fruit    │ Colour │
+├────────────┼────────┤
+│ Strawberry │ Red    │
+└────────────┴────────┘
+`, "\n"+builder.String())
+	assert.Error(t, table.RenderPage(2))
+}
+
+func Test_PageHeightMatchesRenderedLines(t *testing.T) {
+	configs := []func(table *Table){
+		func(table *Table) {},
+		func(table *Table) { table.SetRowLines(false) },
+		func(table *Table) { table.SetBorders(false) },
+		func(table *Table) { table.SetHeaders(); table.SetRowLines(false) },
+		func(table *Table) { table.SetRowLines(false); table.separatedRows = map[int]bool{1: true} },
+		func(table *Table) { table.AddFooters("total", "9"); table.SetPageFooters(true) },
+	}
+	for i, configure := range configs {
+		for height := 4; height <= 14; height++ {
+			builder := &strings.Builder{}
+			table := New(builder)
+			table.SetHeaders("A", "B")
+			table.AddRow("1", "2\n3")
+			table.AddRow("4", "5")
+			table.AddRow("6\n7\n8", "9")
+			table.AddRow("10", "11")
+			configure(table)
+			table.SetPageHeight(height)
+			pages := table.PageCount()
+			for n := 0; n < pages; n++ {
+				builder.Reset()
+				require.NoError(t, table.RenderPage(n))
+				table.formatData()
+				page := table.paginate()[n]
+				// a page can only exceed the height when it has a single row which doesn't fit alone
+				if strings.Count(builder.String(), "\n") > height {
+					assert.Equal(t, 1, page.end-page.start, "config %d, height %d, page %d", i, height, n)
+				}
+				// and the next row must not have fitted, with room left for footers
+				if n < pages-1 {
+					builder.Reset()
+					table.renderRowSet(table.pageRows(pageRange{start: page.start, end: page.end + 1}, true))
+					assert.Greater(t, strings.Count(builder.String(), "\n"), height, "config %d, height %d, page %d", i, height, n)
+				}
+			}
+		}
+	}
+}
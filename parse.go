@@ -0,0 +1,481 @@
+package table
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+// ParseOptions describes the structure of a rendered table, for use with ParseWithOptions
+type ParseOptions struct {
+	// HeaderRows is the number of header rows at the top of the table
+	HeaderRows int
+	// FooterRows is the number of footer rows at the bottom of the table
+	FooterRows int
+	// RowLines indicates that rows are separated by lines, meaning consecutive lines of text belong to a single
+	// (wrapped) row. Otherwise, each line of text between the headers and footers is treated as a separate row.
+	RowLines bool
+	// Writer is where the returned table is rendered to. Defaults to io.Discard.
+	Writer io.Writer
+}
+
+// the runes used by the built-in dividers and themes
+const (
+	parseVerticals   = "│|║┃╎"
+	parseJunctions   = "┼├┤┴┬└┘┐┌╭╮╯╰+╬╠╣╩╦╚╝╗╔╋┣┫┻┳┗┛┓┏┿┝┥┷┯┑┍"
+	parseHorizontals = "─-═━╌="
+)
+
+// Parse reads a table previously rendered with one of the built-in dividers or themes, and reconstructs its headers,
+// rows, footers and column spans. ANSI codes are removed, and the lines of wrapped cells are joined.
+//
+// The structure of the table is detected heuristically: the first row is treated as a header row if all of its cells
+// are centred (the default header alignment), and the last row as a footer row in the same way. Use ParseWithOptions
+// where the structure is already known, or to render the returned table somewhere other than io.Discard.
+func Parse(r io.Reader) (*Table, error) {
+	p, err := newParser(r)
+	if err != nil {
+		return nil, err
+	}
+	return p.table(p.detect()), nil
+}
+
+// ParseWithOptions is the same as Parse, but uses the given structure instead of detecting it
+func ParseWithOptions(r io.Reader, opts ParseOptions) (*Table, error) {
+	p, err := newParser(r)
+	if err != nil {
+		return nil, err
+	}
+	return p.table(opts), nil
+}
+
+// ParseRows is the same as Parse, but returns the cell values of every header, row and footer, in that order
+func ParseRows(r io.Reader) ([][]string, error) {
+	t, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]string
+	rows = append(rows, t.Headers()...)
+	for i := 0; i < t.RowCount(); i++ {
+		rows = append(rows, t.Row(i))
+	}
+	rows = append(rows, t.Footers()...)
+	return rows, nil
+}
+
+// parsedLine is a line of rendered output, with the display position of each rune
+type parsedLine struct {
+	runes     []rune
+	positions map[int]int
+	width     int
+}
+
+func newParsedLine(input string) parsedLine {
+	line := parsedLine{positions: make(map[int]int)}
+	for _, r := range input {
+		line.positions[line.width] = len(line.runes)
+		line.runes = append(line.runes, r)
+		line.width += runewidth.RuneWidth(r)
+	}
+	return line
+}
+
+// at returns the rune which starts at the given display position, if any
+func (l parsedLine) at(x int) rune {
+	if i, ok := l.positions[x]; ok {
+		return l.runes[i]
+	}
+	return 0
+}
+
+// slice returns the runes which start within the given display range
+func (l parsedLine) slice(start, end int) string {
+	var output []rune
+	var x int
+	for _, r := range l.runes {
+		if x >= start && x < end {
+			output = append(output, r)
+		}
+		x += runewidth.RuneWidth(r)
+	}
+	return string(output)
+}
+
+// segments splits a line at each of the given positions which hold a divider, returning the ranges between them
+func (l parsedLine) segments(boundaries []int, isDivider func(rune) bool) []parsedCell {
+	var cells []parsedCell
+	start := 0
+	for _, x := range boundaries {
+		if x < start || !isDivider(l.at(x)) {
+			continue
+		}
+		if x > start {
+			cells = append(cells, parsedCell{start: start, end: x})
+		}
+		start = x + runewidth.RuneWidth(l.at(x))
+	}
+	if start < l.width {
+		cells = append(cells, parsedCell{start: start, end: l.width})
+	}
+	return cells
+}
+
+type parsedCell struct {
+	start int
+	end   int
+	span  int
+	lines []string
+}
+
+// value joins the lines of a wrapped cell, rejoining any words which were broken with a hyphen
+func (c parsedCell) value(padding int) string {
+	var output string
+	for i, line := range c.lines {
+		text := strings.TrimSpace(line)
+		if text == "" {
+			continue
+		}
+		if output != "" && !strings.HasSuffix(output, "-\x00") {
+			output += " "
+		}
+		output = strings.TrimSuffix(output, "-\x00")
+		output += text
+		if i < len(c.lines)-1 && strings.HasSuffix(text, "-") &&
+			runewidth.StringWidth(text) == (c.end-c.start)-(padding*2) {
+			output += "\x00"
+		}
+	}
+	return strings.ReplaceAll(output, "\x00", "")
+}
+
+// centred checks whether the content of the cell has been centred
+func (c parsedCell) centred() bool {
+	for _, line := range c.lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		left := len(line) - len(strings.TrimLeft(line, " "))
+		right := len(line) - len(strings.TrimRight(line, " "))
+		if right-left > 1 || left > right {
+			return false
+		}
+	}
+	return true
+}
+
+// parsedBlock is a group of content lines which are not separated by any horizontal lines
+type parsedBlock struct {
+	lines []parsedLine
+	// partialBelow indicates that the line below the block has gaps for merged cells
+	partialBelow bool
+}
+
+type parser struct {
+	blocks     []parsedBlock
+	boundaries []int
+	padding    int
+}
+
+func newParser(r io.Reader) (*parser, error) {
+	var lines []parsedLine
+	var rules []bool
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		text := strings.TrimRight(newANSI(scanner.Text()).Strip(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		line := newParsedLine(text)
+		lines = append(lines, line)
+		rules = append(rules, isRuleLine(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, errors.New("no table found")
+	}
+
+	p := &parser{}
+
+	// find the positions of column boundaries from the junctions in horizontal lines
+	positions := make(map[int]bool)
+	for i, line := range lines {
+		if !rules[i] {
+			continue
+		}
+		var x int
+		for _, r := range line.runes {
+			if strings.ContainsRune(parseJunctions+parseVerticals, r) {
+				positions[x] = true
+			}
+			x += runewidth.RuneWidth(r)
+		}
+	}
+	// fall back to positions where every line has a vertical divider
+	if len(positions) == 0 {
+		for x := 0; x < lines[0].width; x++ {
+			all := true
+			for _, line := range lines {
+				if !isVertical(line.at(x)) {
+					all = false
+					break
+				}
+			}
+			if all {
+				positions[x] = true
+			}
+		}
+	}
+	if len(positions) == 0 {
+		return nil, errors.New("no table found: no recognised dividers")
+	}
+	for x := range positions {
+		p.boundaries = append(p.boundaries, x)
+	}
+	sort.Ints(p.boundaries)
+
+	var current *parsedBlock
+	for i, line := range lines {
+		if rules[i] {
+			if current != nil {
+				current.partialBelow = isPartialRuleLine(line)
+				p.blocks = append(p.blocks, *current)
+				current = nil
+			}
+			continue
+		}
+		if current == nil {
+			current = &parsedBlock{}
+		}
+		current.lines = append(current.lines, line)
+	}
+	if current != nil {
+		p.blocks = append(p.blocks, *current)
+	}
+	if len(p.blocks) == 0 {
+		return nil, errors.New("no table found: no content")
+	}
+
+	p.padding = p.detectPadding()
+	return p, nil
+}
+
+func isVertical(r rune) bool {
+	return r != 0 && strings.ContainsRune(parseVerticals, r)
+}
+
+func isBoundary(r rune) bool {
+	return r != 0 && strings.ContainsRune(parseJunctions+parseVerticals, r)
+}
+
+// ruleRuns splits a line into runs of runes between junctions/verticals
+func ruleRuns(line parsedLine) []string {
+	var runs []string
+	var current []rune
+	for _, r := range line.runes {
+		if isBoundary(r) {
+			runs = append(runs, string(current))
+			current = nil
+			continue
+		}
+		current = append(current, r)
+	}
+	return append(runs, string(current))
+}
+
+// isRuleLine checks whether a line is a horizontal line, where each run between junctions is either all horizontal
+// dividers, or all spaces (where a merged cell continues through the line)
+func isRuleLine(line parsedLine) bool {
+	var horizontal bool
+	for _, run := range ruleRuns(line) {
+		if run == "" {
+			continue
+		}
+		switch {
+		case strings.Trim(run, parseHorizontals) == "":
+			horizontal = true
+		case strings.TrimSpace(run) == "":
+		default:
+			return false
+		}
+	}
+	return horizontal
+}
+
+func isPartialRuleLine(line parsedLine) bool {
+	for _, run := range ruleRuns(line) {
+		if run != "" && strings.TrimSpace(run) == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// detectPadding finds the smallest number of spaces before any cell content
+func (p *parser) detectPadding() int {
+	padding := -1
+	for _, block := range p.blocks {
+		for _, line := range block.lines {
+			for _, cell := range line.segments(p.boundaries, isVertical) {
+				text := line.slice(cell.start, cell.end)
+				if strings.TrimSpace(text) == "" {
+					continue
+				}
+				left := len(text) - len(strings.TrimLeft(text, " "))
+				if padding < 0 || left < padding {
+					padding = left
+				}
+			}
+		}
+	}
+	if padding < 0 {
+		return 0
+	}
+	return padding
+}
+
+// cells extracts the cells from a group of lines belonging to a single row
+func (p *parser) cells(lines []parsedLine) []parsedCell {
+	cells := lines[0].segments(p.boundaries, isVertical)
+	for i, cell := range cells {
+		cell.span = 1
+		for _, x := range p.boundaries {
+			if x > cell.start && x < cell.end-1 {
+				cell.span++
+			}
+		}
+		for _, line := range lines {
+			cell.lines = append(cell.lines, line.slice(cell.start, cell.end))
+		}
+		cells[i] = cell
+	}
+	return cells
+}
+
+func (p *parser) blockCentred(block parsedBlock) bool {
+	for _, cell := range p.cells(block.lines) {
+		if !cell.centred() {
+			return false
+		}
+	}
+	return true
+}
+
+// detect makes a best guess at the structure of the table
+func (p *parser) detect() ParseOptions {
+	var opts ParseOptions
+	n := len(p.blocks)
+
+	if n >= 2 && p.blockCentred(p.blocks[0]) {
+		opts.HeaderRows = 1
+		// a partial line below a header means a header cell has been merged with the header row below it
+		for opts.HeaderRows < n-1 && p.blocks[opts.HeaderRows-1].partialBelow {
+			opts.HeaderRows++
+		}
+	}
+
+	if n-opts.HeaderRows >= 2 && p.blockCentred(p.blocks[n-1]) && !p.blockCentred(p.blocks[n-2]) {
+		opts.FooterRows = 1
+	}
+
+	// when there are several groups of rows, they must be separated by row lines
+	opts.RowLines = n-opts.HeaderRows-opts.FooterRows > 1
+	return opts
+}
+
+func (p *parser) table(opts ParseOptions) *Table {
+	w := opts.Writer
+	if w == nil {
+		w = io.Discard
+	}
+	t := New(w)
+
+	n := len(p.blocks)
+	headers := opts.HeaderRows
+	if headers > n {
+		headers = n
+	}
+	footers := opts.FooterRows
+	if footers > n-headers {
+		footers = n - headers
+	}
+
+	var previous []parsedCell
+	for i, block := range p.blocks[:headers] {
+		cells := p.cells(block.lines)
+		var values []string
+		var spans []int
+		for _, cell := range cells {
+			value := cell.value(p.padding)
+			// fill in cells which were merged with the header above
+			if value == "" && i > 0 && p.blocks[i-1].partialBelow {
+				for _, above := range previous {
+					if above.start == cell.start {
+						value = above.value(p.padding)
+					}
+				}
+			}
+			values = append(values, value)
+			spans = append(spans, cell.span)
+		}
+		t.AddHeaders(values...)
+		if hasSpans(spans) {
+			t.SetHeaderColSpans(i, spans...)
+		}
+		previous = cells
+	}
+
+	var rows [][]parsedLine
+	for _, block := range p.blocks[headers : n-footers] {
+		if opts.RowLines {
+			rows = append(rows, block.lines)
+			continue
+		}
+		for _, line := range block.lines {
+			rows = append(rows, []parsedLine{line})
+		}
+	}
+	for i, row := range rows {
+		values, spans := p.values(row)
+		t.AddRow(values...)
+		if hasSpans(spans) {
+			t.SetColSpans(i, spans...)
+		}
+	}
+
+	for i, block := range p.blocks[n-footers:] {
+		values, spans := p.values(block.lines)
+		t.AddFooters(values...)
+		if hasSpans(spans) {
+			t.SetFooterColSpans(i, spans...)
+		}
+	}
+
+	return t
+}
+
+func (p *parser) values(lines []parsedLine) ([]string, []int) {
+	var values []string
+	var spans []int
+	for _, cell := range p.cells(lines) {
+		values = append(values, cell.value(p.padding))
+		spans = append(spans, cell.span)
+	}
+	return values, spans
+}
+
+func hasSpans(spans []int) bool {
+	for _, span := range spans {
+		if span > 1 {
+			return true
+		}
+	}
+	return false
+}
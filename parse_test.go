@@ -0,0 +1,131 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseUnicode(t *testing.T) {
+	input := `
+┌────┬──────────────┬───────┐
+│ ID │    Fruit     │ Stock │
+├────┼──────────────┼───────┤
+│ 1  │ Apple        │ 14    │
+├────┼──────────────┼───────┤
+│ 2  │ Banana is a  │ 88    │
+│    │ yellow fruit │       │
+├────┼──────────────┴───────┤
+│ 3  │ Out of stock         │
+├────┼──────────────┬───────┤
+│    │    Total     │  102  │
+└────┴──────────────┴───────┘
+`
+	parsed, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"ID", "Fruit", "Stock"}}, parsed.Headers())
+	assert.Equal(t, [][]string{
+		{"1", "Apple", "14"},
+		{"2", "Banana is a yellow fruit", "88"},
+		{"3", "Out of stock"},
+	}, dataRows(parsed))
+	assert.Equal(t, [][]string{{"", "Total", "102"}}, parsed.Footers())
+	assert.Equal(t, map[int][]int{2: {1, 2}}, parsed.contentColspans)
+}
+
+func Test_ParseRoundTrip(t *testing.T) {
+	for _, dividers := range []Dividers{UnicodeDividers, UnicodeRoundedDividers, ASCIIDividers} {
+		builder := &strings.Builder{}
+		table := New(builder)
+		table.SetDividers(dividers)
+		table.SetColumnMaxWidth(12)
+		table.SetAvailableWidth(30)
+		table.SetHeaders("Name", "Description")
+		table.AddRow("\x1b[31mA\x1b[0m", "antidisestablishmentarianism")
+		table.AddRow("B", "A long description which wraps")
+		table.Render()
+
+		parsed, err := Parse(strings.NewReader(builder.String()))
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"Name", "Description"}}, parsed.Headers())
+		assert.Equal(t, [][]string{
+			{"A", "antidisestablishmentarianism"},
+			{"B", "A long description which wraps"},
+		}, dataRows(parsed))
+	}
+}
+
+func Test_ParseMarkdown(t *testing.T) {
+	input := `
+| A |  B   |
+|---|------|
+| 1 | 2    |
+| 3 | 4    |
+`
+	rows, err := ParseRows(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"A", "B"}, {"1", "2"}, {"3", "4"}}, rows)
+}
+
+func Test_ParseMultipleHeaderRows(t *testing.T) {
+	input := `
+┌──────┬───────────┐
+│ Name │   Stats   │
+│      ├─────┬─────┤
+│      │ Min │ Max │
+├──────┼─────┼─────┤
+│ A    │ 1   │ 2   │
+└──────┴─────┴─────┘
+`
+	parsed, err := Parse(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Name", "Stats"}, {"Name", "Min", "Max"}}, parsed.Headers())
+	assert.Equal(t, map[int][]int{0: {1, 2}}, parsed.headerColspans)
+	assert.Equal(t, [][]string{{"A", "1", "2"}}, dataRows(parsed))
+}
+
+func Test_ParseWithOptions(t *testing.T) {
+	input := `
++---+---+
+| 1 | 2 |
+| 3 | 4 |
++---+---+
+`
+	parsed, err := ParseWithOptions(strings.NewReader(input), ParseOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, parsed.Headers())
+	assert.Equal(t, [][]string{{"1", "2"}, {"3", "4"}}, dataRows(parsed))
+
+	parsed, err = ParseWithOptions(strings.NewReader(input), ParseOptions{RowLines: true})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"1 3", "2 4"}}, dataRows(parsed))
+}
+
+func Test_ParseNoTable(t *testing.T) {
+	_, err := Parse(strings.NewReader("hello world\n"))
+	require.Error(t, err)
+}
+
+func dataRows(table *Table) [][]string {
+	var rows [][]string
+	for i := 0; i < table.RowCount(); i++ {
+		rows = append(rows, table.Row(i))
+	}
+	return rows
+}
+
+func Test_ParseWithWriter(t *testing.T) {
+	input := `┌───┬───┐
+│ A │ B │
+├───┼───┤
+│ 1 │ 2 │
+└───┴───┘
+`
+	builder := &strings.Builder{}
+	parsed, err := ParseWithOptions(strings.NewReader(input), ParseOptions{HeaderRows: 1, RowLines: true, Writer: builder})
+	require.NoError(t, err)
+	parsed.Render()
+	assert.Equal(t, input, builder.String())
+}
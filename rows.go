@@ -0,0 +1,275 @@
+package table
+
+// InsertRow inserts a row before the row at the given index, or at the end of the table if the index is the number
+// of rows. Column spans set with SetColSpans stay with the rows they were set on. Invalid indexes are ignored.
+func (t *Table) InsertRow(index int, cols ...string) {
+	if index < 0 || index > len(t.data) {
+		return
+	}
+	t.data = append(t.data[:index], append([][]string{cols}, t.data[index:]...)...)
+	t.contentColspans = shiftRows(t.contentColspans, index, 1)
+	t.separatedRows = shiftRows(t.separatedRows, index, 1)
+}
+
+// SetRow replaces the row at the given index. Invalid indexes are ignored.
+func (t *Table) SetRow(index int, cols ...string) {
+	if index < 0 || index >= len(t.data) {
+		return
+	}
+	t.data[index] = cols
+}
+
+// DeleteRow removes the row at the given index, along with any column spans set for it. Invalid indexes are ignored.
+func (t *Table) DeleteRow(index int) {
+	if index < 0 || index >= len(t.data) {
+		return
+	}
+	t.data = append(t.data[:index], t.data[index+1:]...)
+	delete(t.contentColspans, index)
+	delete(t.separatedRows, index)
+	t.contentColspans = shiftRows(t.contentColspans, index+1, -1)
+	t.separatedRows = shiftRows(t.separatedRows, index+1, -1)
+}
+
+// ReorderRows rearranges the rows so that row i is the row which was previously at order[i], e.g. to sort them.
+// Column spans set with SetColSpans stay with the rows they were set on. Orders which aren't a permutation of the
+// row indexes are ignored.
+func (t *Table) ReorderRows(order []int) {
+	if len(order) != len(t.data) {
+		return
+	}
+	seen := make([]bool, len(order))
+	for _, index := range order {
+		if index < 0 || index >= len(order) || seen[index] {
+			return
+		}
+		seen[index] = true
+	}
+	data := make([][]string, len(order))
+	for i, index := range order {
+		data[i] = t.data[index]
+	}
+	t.data = data
+	t.contentColspans = reorderRows(t.contentColspans, order)
+	t.separatedRows = reorderRows(t.separatedRows, order)
+}
+
+// SetCell sets the value of a cell, adding empty cells to the row if it is too short. Invalid indexes are ignored.
+func (t *Table) SetCell(row int, col int, value string) {
+	if row < 0 || row >= len(t.data) || col < 0 {
+		return
+	}
+	// copy the row, which may share its backing array with a slice passed to AddRows
+	cells := make([]string, len(t.data[row]), len(t.data[row])+col+1)
+	copy(cells, t.data[row])
+	for len(cells) <= col {
+		cells = append(cells, "")
+	}
+	cells[col] = value
+	t.data[row] = cells
+}
+
+// Row returns a copy of the row at the given index, or nil if there is no such row
+func (t *Table) Row(index int) []string {
+	if index < 0 || index >= len(t.data) {
+		return nil
+	}
+	return append([]string(nil), t.data[index]...)
+}
+
+// Headers returns a copy of the header rows
+func (t *Table) Headers() [][]string {
+	return copyRows(t.headers)
+}
+
+// Footers returns a copy of the footer rows
+func (t *Table) Footers() [][]string {
+	return copyRows(t.footers)
+}
+
+// Cell returns the value of a cell, or an empty string if there is no such cell
+func (t *Table) Cell(row int, col int) string {
+	if row < 0 || row >= len(t.data) || col < 0 || col >= len(t.data[row]) {
+		return ""
+	}
+	return t.data[row][col]
+}
+
+// InsertColumn inserts a column before the column at the given index, with the given header in the last header row
+// and a value for each row. Rows without a value, other header rows and footers have an empty cell inserted. Cells
+// which span across the index are widened rather than having a cell inserted. Settings made by position, such as
+// with SetAlignment, are moved along with their columns.
+func (t *Table) InsertColumn(index int, header string, values ...string) {
+	if index < 0 {
+		return
+	}
+	for r := range t.headers {
+		var value string
+		if r == len(t.headers)-1 {
+			value = header
+		}
+		t.headers[r] = insertCell(t.headers[r], t.headerColspans, r, index, value)
+	}
+	for r := range t.data {
+		var value string
+		if r < len(values) {
+			value = values[r]
+		}
+		t.data[r] = insertCell(t.data[r], t.contentColspans, r, index, value)
+	}
+	for r := range t.footers {
+		t.footers[r] = insertCell(t.footers[r], t.footerColspans, r, index, "")
+	}
+
+	t.alignments = insertSetting(t.alignments, index, AlignLeft)
+	t.headerAlignments = insertSetting(t.headerAlignments, index, AlignCenter)
+	t.footerAlignments = insertSetting(t.footerAlignments, index, AlignCenter)
+	t.wrapping = insertSetting(t.wrapping, index, WrapDefault)
+	t.decimalSeparators = insertSetting(t.decimalSeparators, index, "")
+	t.columnLinks = shiftRows(t.columnLinks, index, 1)
+}
+
+// DeleteColumn removes the column at the given index from the headers, rows and footers. Cells which span across
+// the index are narrowed rather than removed. Settings made by position, such as with SetAlignment, are moved along
+// with their columns.
+func (t *Table) DeleteColumn(index int) {
+	if index < 0 {
+		return
+	}
+	for r := range t.headers {
+		t.headers[r] = deleteCell(t.headers[r], t.headerColspans, r, index)
+	}
+	for r := range t.data {
+		t.data[r] = deleteCell(t.data[r], t.contentColspans, r, index)
+	}
+	for r := range t.footers {
+		t.footers[r] = deleteCell(t.footers[r], t.footerColspans, r, index)
+	}
+
+	t.alignments = deleteSetting(t.alignments, index)
+	t.headerAlignments = deleteSetting(t.headerAlignments, index)
+	t.footerAlignments = deleteSetting(t.footerAlignments, index)
+	t.wrapping = deleteSetting(t.wrapping, index)
+	t.decimalSeparators = deleteSetting(t.decimalSeparators, index)
+	delete(t.columnLinks, index)
+	t.columnLinks = shiftRows(t.columnLinks, index+1, -1)
+}
+
+// shiftRows moves the entries of a map keyed by index, from the given index onwards
+func shiftRows[T any](entries map[int]T, from int, by int) map[int]T {
+	if entries == nil {
+		return nil
+	}
+	shifted := make(map[int]T, len(entries))
+	for index, entry := range entries {
+		if index >= from {
+			index += by
+		}
+		shifted[index] = entry
+	}
+	return shifted
+}
+
+// reorderRows moves the entries of a map keyed by index to the new positions of their rows, where order[i] is the
+// previous index of the row now at i
+func reorderRows[T any](entries map[int]T, order []int) map[int]T {
+	if entries == nil {
+		return nil
+	}
+	reordered := make(map[int]T, len(entries))
+	for i, index := range order {
+		if entry, ok := entries[index]; ok {
+			reordered[i] = entry
+		}
+	}
+	return reordered
+}
+
+// rowWidth counts the logical columns a row covers
+func rowWidth(row []string, spans []int) int {
+	width := len(row)
+	for c, span := range spans {
+		if c < len(row) && span > 1 {
+			width += span - 1
+		}
+	}
+	return width
+}
+
+// findCell finds the cell in a row which covers a logical column, returning the number of cells if there isn't one
+func findCell(row []string, spans []int, index int) (int, bool) {
+	var relative int
+	for c := range row {
+		span := 1
+		if c < len(spans) && spans[c] > 1 {
+			span = spans[c]
+		}
+		if index < relative+span {
+			return c, index > relative
+		}
+		relative += span
+	}
+	return len(row), false
+}
+
+// insertCell inserts a cell into a row at a logical column, or widens the cell spanning across it
+func insertCell(row []string, colspans map[int][]int, r int, index int, value string) []string {
+	spans := colspans[r]
+	c, within := findCell(row, spans, index)
+	if within {
+		colspans[r] = append([]int(nil), spans...)
+		colspans[r][c]++
+		return row
+	}
+	if c < len(spans) {
+		colspans[r] = insertSetting(spans, c, 1)
+	}
+	if c < len(row) {
+		return insertSetting(row, c, value)
+	}
+	// pad rows which are too short to reach the index
+	cells := append([]string(nil), row...)
+	for width := rowWidth(row, spans); width < index; width++ {
+		cells = append(cells, "")
+	}
+	return append(cells, value)
+}
+
+// deleteCell removes the cell from a row at a logical column, or narrows the cell spanning across it
+func deleteCell(row []string, colspans map[int][]int, r int, index int) []string {
+	spans := colspans[r]
+	c, _ := findCell(row, spans, index)
+	if c >= len(row) {
+		return row
+	}
+	if c < len(spans) && spans[c] > 1 {
+		colspans[r] = append([]int(nil), spans...)
+		colspans[r][c]--
+		return row
+	}
+	if c < len(spans) {
+		colspans[r] = deleteSetting(spans, c)
+	}
+	return deleteSetting(row, c)
+}
+
+// insertSetting inserts a value into a slice of settings by column, which is left alone if it doesn't reach the index
+func insertSetting[T any](settings []T, index int, value T) []T {
+	if index >= len(settings) {
+		return settings
+	}
+	output := make([]T, 0, len(settings)+1)
+	output = append(output, settings[:index]...)
+	output = append(output, value)
+	return append(output, settings[index:]...)
+}
+
+// deleteSetting removes a value from a slice of settings by column
+func deleteSetting[T any](settings []T, index int) []T {
+	if index >= len(settings) {
+		return settings
+	}
+	output := make([]T, 0, len(settings)-1)
+	output = append(output, settings[:index]...)
+	return append(output, settings[index+1:]...)
+}
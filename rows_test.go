@@ -0,0 +1,171 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InsertRow(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.AddRow("a", "b")
+	table.AddRow("c")
+	table.SetColSpans(1, 2)
+	table.InsertRow(0, "first", "row")
+	table.InsertRow(3, "last", "row")
+	table.InsertRow(5, "ignored")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────┬─────┐
+│ first │ row │
+├───────┼─────┤
+│ a     │ b   │
+├───────┴─────┤
+│ c           │
+├───────┬─────┤
+│ last  │ row │
+└───────┴─────┘
+`, "\n"+builder.String())
+}
+
+func Test_DeleteRow(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.AddRow("a", "b")
+	table.AddRow("c", "d")
+	table.AddRow("e")
+	table.SetColSpans(2, 2)
+	table.DeleteRow(0)
+	table.DeleteRow(-1)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ c │ d │
+├───┴───┤
+│ e     │
+└───────┘
+`, "\n"+builder.String())
+}
+
+func Test_SetRowAndCell(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B", "C")
+	table.AddRow("1", "2", "3")
+	table.AddRow("4")
+	table.Render()
+
+	table.SetRow(0, "x", "y", "z")
+	table.SetCell(1, 2, "6")
+	table.SetCell(5, 0, "ignored")
+	assert.Equal(t, "y", table.Cell(0, 1))
+	assert.Equal(t, "", table.Cell(1, 1))
+	assert.Equal(t, "", table.Cell(3, 0))
+
+	builder.Reset()
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┬───┐
+│ A │ B │ C │
+├───┼───┼───┤
+│ x │ y │ z │
+├───┼───┼───┤
+│ 4 │   │ 6 │
+└───┴───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_SetCellDoesNotModifyInput(t *testing.T) {
+	rows := [][]string{{"a", "b"}}
+	table := New(&strings.Builder{})
+	table.AddRows(rows...)
+	table.SetCell(0, 0, "c")
+	assert.Equal(t, "a", rows[0][0])
+	assert.Equal(t, "c", table.Cell(0, 0))
+}
+
+func Test_InsertColumn(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "Stats")
+	table.AddHeaders("Name", "Min", "Max")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.SetAlignment(AlignLeft, AlignRight, AlignRight)
+	table.AddRow("a", "1", "10")
+	table.AddRow("b", "100", "2")
+	table.SetFooters("Total", "101", "12")
+	table.InsertColumn(2, "Avg", "5.5", "51")
+	table.InsertColumn(1, "Kind", "x")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────┬──────┬─────────────────┐
+│ Name  │      │      Stats      │
+├───────┼──────┼─────┬─────┬─────┤
+│ Name  │ Kind │ Min │ Avg │ Max │
+├───────┼──────┼─────┼─────┼─────┤
+│ a     │ x    │   1 │ 5.5 │  10 │
+├───────┼──────┼─────┼─────┼─────┤
+│ b     │      │ 100 │ 51  │   2 │
+├───────┼──────┼─────┼─────┼─────┤
+│ Total │      │ 101 │     │ 12  │
+└───────┴──────┴─────┴─────┴─────┘
+`, "\n"+builder.String())
+}
+
+func Test_DeleteColumn(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "Stats")
+	table.AddHeaders("Name", "Min", "Max")
+	table.SetHeaderColSpans(0, 1, 2)
+	table.SetAlignment(AlignLeft, AlignLeft, AlignRight)
+	table.AddRow("a", "1", "10")
+	table.AddRow("b", "100", "2")
+	table.DeleteColumn(1)
+	table.DeleteColumn(7)
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────┬───────┐
+│ Name │ Stats │
+├──────┼───────┤
+│ Name │  Max  │
+├──────┼───────┤
+│ a    │    10 │
+├──────┼───────┤
+│ b    │     2 │
+└──────┴───────┘
+`, "\n"+builder.String())
+}
+
+func Test_ClearResetsColSpans(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.AddRow("a")
+	table.AddRow("b", "c")
+	table.SetColSpans(0, 2)
+	table.Clear()
+	table.AddRow("d", "e")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┐
+│ d │ e │
+└───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_ReorderRows(t *testing.T) {
+	table := New(&strings.Builder{})
+	table.AddRow("a")
+	table.AddRow("b", "c")
+	table.SetColSpans(1, 2)
+	table.ReorderRows([]int{1, 0})
+	assert.Equal(t, []string{"b", "c"}, table.Row(0))
+	assert.Equal(t, []string{"a"}, table.Row(1))
+	assert.Equal(t, map[int][]int{0: {2}}, table.contentColspans)
+
+	// not a permutation
+	table.ReorderRows([]int{0, 0})
+	assert.Equal(t, []string{"b", "c"}, table.Row(0))
+}
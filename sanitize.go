@@ -0,0 +1,90 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// SanitizeMode dictates how escape sequences and control characters in cell content are handled
+type SanitizeMode uint8
+
+const (
+	// SanitizeStyles allows SGR escape sequences (colours, bold etc.) through, but makes any other escape sequences
+	// and control characters visible, so they can't move the cursor, clear the screen or otherwise affect the
+	// terminal (the default)
+	SanitizeStyles SanitizeMode = iota
+	// SanitizeStrip removes SGR escape sequences, and makes any other escape sequences and control characters visible
+	SanitizeStrip
+	// SanitizeNone writes cell content verbatim. Only use this for trusted content.
+	SanitizeNone
+	// SanitizeStylesAndLinks is the same as SanitizeStyles, but also allows OSC 8 hyperlinks in cell data through.
+	// Links created with Link are allowed through by SanitizeStyles too.
+	SanitizeStylesAndLinks
+)
+
+// SetSanitize sets how escape sequences and control characters in cell content are handled. Defaults to
+// SanitizeStyles, which protects the terminal from hostile content such as package names or image labels.
+func (t *Table) SetSanitize(mode SanitizeMode) {
+	t.sanitize = mode
+}
+
+// sanitizeContent applies the sanitize mode to a cell value. Newlines are retained, and tabs are replaced with a
+// space, as their width depends on where they are rendered.
+func sanitizeContent(input string, mode SanitizeMode) string {
+	if mode == SanitizeNone {
+		return unmarkLinks(input)
+	}
+	var output strings.Builder
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		if r == 0x1b {
+			if length, kind := escapeLength(input[i:]); length > 1 {
+				sequence := input[i : i+length]
+				hyperlink := kind == escapeOSC && strings.HasPrefix(sequence, "\x1b]8;")
+				switch {
+				case kind == escapeSGR && mode != SanitizeStrip:
+					output.WriteString(sequence)
+				case hyperlink && (mode == SanitizeStylesAndLinks || (mode == SanitizeStyles && isLink(sequence))):
+					output.WriteString(unmarkLinks(sequence))
+				case kind == escapeSGR, hyperlink:
+					// styles and hyperlinks are removed, leaving the text
+				default:
+					output.WriteString(visibleControl(r))
+					output.WriteString(sanitizeContent(input[i+size:i+length], mode))
+				}
+				i += length
+				continue
+			}
+		}
+		switch {
+		case r == '\n':
+			output.WriteRune(r)
+		case r == '\t':
+			output.WriteRune(' ')
+		case isControl(r):
+			output.WriteString(visibleControl(r))
+		default:
+			output.WriteString(input[i : i+size])
+		}
+		i += size
+	}
+	return output.String()
+}
+
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f || (r >= 0x80 && r <= 0x9f)
+}
+
+// visibleControl returns a visible representation of a control character, using the Unicode control pictures where
+// they exist
+func visibleControl(r rune) string {
+	switch {
+	case r < 0x20:
+		return string(rune(0x2400 + r))
+	case r == 0x7f:
+		return "␡"
+	default:
+		return fmt.Sprintf("\\x%02x", r)
+	}
+}
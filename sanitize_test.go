@@ -0,0 +1,64 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SanitizeContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mode     SanitizeMode
+		expected string
+	}{
+		{name: "plain text", input: "hello", mode: SanitizeStyles, expected: "hello"},
+		{name: "styles kept", input: "\x1b[1;31mred\x1b[0m", mode: SanitizeStyles, expected: "\x1b[1;31mred\x1b[0m"},
+		{name: "styles stripped", input: "\x1b[1;31mred\x1b[0m", mode: SanitizeStrip, expected: "red"},
+		{name: "clear screen", input: "\x1b[2Jhi", mode: SanitizeStyles, expected: "␛[2Jhi"},
+		{name: "cursor movement", input: "a\x1b[1Ab", mode: SanitizeStrip, expected: "a␛[1Ab"},
+		{name: "osc title", input: "\x1b]0;pwned\x07", mode: SanitizeStyles, expected: "␛]0;pwned␇"},
+		{name: "carriage return", input: "safe\rEVIL", mode: SanitizeStyles, expected: "safe␍EVIL"},
+		{name: "backspace", input: "ab\b\bcd", mode: SanitizeStyles, expected: "ab␈␈cd"},
+		{name: "c1 control", input: "a\u009b2Jb", mode: SanitizeStyles, expected: "a\\x9b2Jb"},
+		{name: "unterminated", input: "a\x1b[", mode: SanitizeStyles, expected: "a␛["},
+		{name: "newlines and tabs", input: "a\tb\nc", mode: SanitizeStyles, expected: "a b\nc"},
+		{name: "none", input: "\x1b[2J\r", mode: SanitizeNone, expected: "\x1b[2J\r"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, sanitizeContent(test.input, test.mode))
+		})
+	}
+}
+
+func Test_SanitizeByDefault(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Package", "Version")
+	table.AddRow("evil\x1b[2J\rpkg", "\x1b[32m1.0\x1b[0m")
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────────────┬─────────┐
+│   Package    │ Version │
+├──────────────┼─────────┤
+│ evil␛[2J␍pkg │ `+"\x1b[32m1.0\x1b[0m"+`     │
+└──────────────┴─────────┘
+`, "\n"+builder.String())
+}
+
+func Test_SanitizeOutputFormats(t *testing.T) {
+	for _, format := range []Format{FormatMarkdown, FormatHTML, FormatCSV} {
+		builder := &strings.Builder{}
+		table := New(builder)
+		table.SetHeaders("Package")
+		table.AddRow("evil\x1b[2J\r\b\apkg")
+		table.SetFormat(format)
+		table.Render()
+		assert.Contains(t, builder.String(), "evil␛[2J␍␈␇pkg")
+		assert.NotContains(t, builder.String(), "\x1b")
+		assert.NotContains(t, builder.String(), "\r")
+	}
+}
@@ -0,0 +1,197 @@
+package table
+
+// SetSplitColumns sets whether a table which is wider than the available width should be split into several
+// stacked tables, each containing a group of columns which fits. This is an alternative to wrapping cell content.
+func (t *Table) SetSplitColumns(enabled bool) {
+	t.splitColumns = enabled
+}
+
+// SetFrozenColumns sets the number of leftmost columns which are kept in view when the table is too wide. They are
+// repeated in every group when a table is split (see SetSplitColumns), and pinned when scrolling horizontally in the
+// viewer package. Cells which span across the edge of the frozen columns are split, with their content repeated in
+// each part.
+func (t *Table) SetFrozenColumns(n int) {
+	t.frozenColumns = n
+}
+
+type columnRange struct {
+	start int
+	end   int
+}
+
+// renderedWidth calculates the total width of the formatted table, including borders
+func (t *Table) renderedWidth() int {
+	if len(t.formatted) == 0 {
+		return 0
+	}
+	dw := t.dividerWidth()
+	var width int
+	if t.borders.Left {
+		width += dw
+	}
+	for _, col := range t.formatted[0].cols {
+		width += col.width + (t.padding * 2)
+		if !col.last || t.borders.Right {
+			width += dw
+		}
+	}
+	return width
+}
+
+// columnWidths finds the formatted width of each column, ignoring cells which span several columns
+func (t *Table) columnWidths() []int {
+	var widths []int
+	for _, row := range t.formatted {
+		var relative int
+		for _, col := range row.cols {
+			for relative+col.span > len(widths) {
+				widths = append(widths, 0)
+			}
+			if col.span == 1 && col.width > widths[relative] {
+				widths[relative] = col.width
+			}
+			relative += col.span
+		}
+	}
+	return widths
+}
+
+// frozen returns the number of frozen columns, leaving at least one column which isn't frozen
+func (t *Table) frozen(columns int) int {
+	frozen := t.frozenColumns
+	if frozen >= columns {
+		frozen = columns - 1
+	}
+	if frozen < 0 {
+		frozen = 0
+	}
+	return frozen
+}
+
+// columnGroups divides the columns into groups which fit within the available width, each prefixed by the frozen
+// columns
+func (t *Table) columnGroups() [][]columnRange {
+	widths := t.columnWidths()
+	keys := t.frozen(len(widths))
+
+	dw := t.dividerWidth()
+	keyWidth := dw
+	for c := 0; c < keys; c++ {
+		keyWidth += widths[c] + (t.padding * 2) + dw
+	}
+
+	var groups [][]columnRange
+	for start := keys; start < len(widths); {
+		width := keyWidth
+		end := start
+		for end < len(widths) {
+			required := widths[end] + (t.padding * 2) + dw
+			if end > start && width+required > t.availableWidth {
+				break
+			}
+			width += required
+			end++
+		}
+		var group []columnRange
+		if keys > 0 {
+			group = append(group, columnRange{start: 0, end: keys})
+		}
+		groups = append(groups, append(group, columnRange{start: start, end: end}))
+		start = end
+	}
+	return groups
+}
+
+func (t *Table) renderSplit() {
+	for i, group := range t.columnGroups() {
+		if i > 0 {
+			t.print("\n")
+		}
+		t.selectColumns(group).Render()
+	}
+}
+
+// selectColumns creates a copy of the table containing only the given ranges of columns.
+// Cells which span across the edge of a range are split, with their content repeated in each part.
+func (t *Table) selectColumns(ranges []columnRange) *Table {
+	s := t.clone()
+	s.splitColumns = false
+	s.separatedRows = t.separatedRows
+
+	var selected []int
+	for _, r := range ranges {
+		for c := r.start; c < r.end; c++ {
+			selected = append(selected, c)
+		}
+	}
+	// settings by logical column are selected along with their columns, while settings by cell position, such as
+	// alignments, are looked up through the cells which were selected (see sourceCell)
+	s.wrapping, s.decimalSeparators = nil, nil
+	for _, c := range selected {
+		s.wrapping = append(s.wrapping, t.getWrapping(c))
+		s.decimalSeparators = append(s.decimalSeparators, t.getDecimalSeparator(c))
+	}
+	s.cellSources = make(map[cellRow][]int)
+
+	selectRow := func(row []string, header bool, footer bool, index int) ([]string, []int) {
+		var cells []string
+		var spans []int
+		var sources []int
+		for _, r := range ranges {
+			var relative int
+			for c, value := range row {
+				span := t.getColspan(header, footer, index, c)
+				start, end := relative, relative+span
+				relative = end
+				if start < r.start {
+					start = r.start
+				}
+				if end > r.end {
+					end = r.end
+				}
+				if start >= end {
+					continue
+				}
+				cells = append(cells, value)
+				spans = append(spans, end-start)
+				sources = append(sources, t.sourceCell(header, footer, index, c))
+			}
+		}
+		s.cellSources[cellRow{header: header, footer: footer, row: index}] = sources
+		return cells, spans
+	}
+
+	for i, row := range t.headers {
+		cells, spans := selectRow(row, true, false, i)
+		s.headers = append(s.headers, cells)
+		s.headerColspans[i] = spans
+	}
+	for i, row := range t.data {
+		cells, spans := selectRow(row, false, false, i)
+		s.data = append(s.data, cells)
+		s.contentColspans[i] = spans
+	}
+	for i, row := range t.footers {
+		cells, spans := selectRow(row, false, true, i)
+		s.footers = append(s.footers, cells)
+		s.footerColspans[i] = spans
+	}
+
+	return s
+}
+
+// cellRow identifies a header, data or footer row
+type cellRow struct {
+	header bool
+	footer bool
+	row    int
+}
+
+// sourceCell finds the index of the cell which a cell was selected from by selectColumns, which is the same as its
+// own index in tables which weren't created by selectColumns
+func (t *Table) sourceCell(header bool, footer bool, row int, col int) int {
+	if sources, ok := t.cellSources[cellRow{header: header, footer: footer, row: row}]; ok && col < len(sources) {
+		return sources[col]
+	}
+	return col
+}
@@ -0,0 +1,151 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_SplitColumns(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Namespace", "Resource", "Vulnerabilities", "Misconfigurations")
+	table.AddHeaders("Namespace", "Resource", "Critical", "High", "Medium", "Low", "Unknown", "Critical", "High", "Medium", "Low", "Unknown")
+	table.SetHeaderColSpans(0, 1, 1, 5, 5)
+	table.SetAutoMergeHeaders(true)
+	table.SetAvailableWidth(80)
+	table.SetSplitColumns(true)
+	table.SetFrozenColumns(2)
+	table.AddRow("default", "Deployment/app", "2", "5", "7", "8", "0", "0", "3", "5", "19", "0")
+	table.AddRow("default", "Ingress/test", "-", "-", "-", "-", "-", "1", "0", "2", "17", "0")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────────┬────────────────┬──────────────────────────────────────────┐
+│ Namespace │    Resource    │             Vulnerabilities              │
+│           │                ├──────────┬──────┬────────┬─────┬─────────┤
+│           │                │ Critical │ High │ Medium │ Low │ Unknown │
+├───────────┼────────────────┼──────────┼──────┼────────┼─────┼─────────┤
+│ default   │ Deployment/app │ 2        │ 5    │ 7      │ 8   │ 0       │
+├───────────┼────────────────┼──────────┼──────┼────────┼─────┼─────────┤
+│ default   │ Ingress/test   │ -        │ -    │ -      │ -   │ -       │
+└───────────┴────────────────┴──────────┴──────┴────────┴─────┴─────────┘
+
+┌───────────┬────────────────┬──────────────────────────────────────────┐
+│ Namespace │    Resource    │            Misconfigurations             │
+│           │                ├──────────┬──────┬────────┬─────┬─────────┤
+│           │                │ Critical │ High │ Medium │ Low │ Unknown │
+├───────────┼────────────────┼──────────┼──────┼────────┼─────┼─────────┤
+│ default   │ Deployment/app │ 0        │ 3    │ 5      │ 19  │ 0       │
+├───────────┼────────────────┼──────────┼──────┼────────┼─────┼─────────┤
+│ default   │ Ingress/test   │ 1        │ 0    │ 2      │ 17  │ 0       │
+└───────────┴────────────────┴──────────┴──────┴────────┴─────┴─────────┘
+`, "\n"+builder.String())
+}
+
+func Test_SplitColumnsWithinColSpan(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Service", "Misconfigurations")
+	table.AddHeaders("Service", "Critical", "High", "Medium", "Low")
+	table.SetHeaderColSpans(0, 1, 4)
+	table.SetAutoMergeHeaders(true)
+	table.SetRowLines(false)
+	table.SetAvailableWidth(30)
+	table.SetSplitColumns(true)
+	table.SetFrozenColumns(1)
+	table.AddRow("ec2", "1", "2", "5", "0")
+	table.Render()
+	assertMultilineEqual(t, `
+┌─────────┬───────────────────┐
+│ Service │ Misconfigurations │
+│         ├───────────┬───────┤
+│         │ Critical  │ High  │
+├─────────┼───────────┼───────┤
+│ ec2     │ 1         │ 2     │
+└─────────┴───────────┴───────┘
+
+┌─────────┬───────────────────┐
+│ Service │ Misconfigurations │
+│         ├──────────┬────────┤
+│         │  Medium  │  Low   │
+├─────────┼──────────┼────────┤
+│ ec2     │ 5        │ 0      │
+└─────────┴──────────┴────────┘
+`, "\n"+builder.String())
+}
+
+func Test_SplitColumnsAcrossFrozenColumns(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Resource", "Findings")
+	table.AddHeaders("Kind", "Name", "Critical", "High")
+	table.SetHeaderColSpans(0, 2, 2)
+	table.SetRowLines(false)
+	table.SetAvailableWidth(30)
+	table.SetSplitColumns(true)
+	table.SetFrozenColumns(1)
+	table.AddRow("Pod", "web", "1", "2")
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────────┬──────────┬──────────┐
+│ Resource │ Resource │ Findings │
+├──────────┼──────────┼──────────┤
+│   Kind   │   Name   │ Critical │
+├──────────┼──────────┼──────────┤
+│ Pod      │ web      │ 1        │
+└──────────┴──────────┴──────────┘
+
+┌──────────┬──────────┐
+│ Resource │ Findings │
+├──────────┼──────────┤
+│   Kind   │   High   │
+├──────────┼──────────┤
+│ Pod      │ 2        │
+└──────────┴──────────┘
+`, "\n"+builder.String())
+}
+
+func Test_SplitColumnsNotRequired(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B", "C")
+	table.SetSplitColumns(true)
+	table.AddRow("1", "2", "3")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┬───┐
+│ A │ B │ C │
+├───┼───┼───┤
+│ 1 │ 2 │ 3 │
+└───┴───┴───┘
+`, "\n"+builder.String())
+}
+
+func Test_SplitColumnsAlignmentsWithColSpans(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Alpha", "Beta", "Gamma", "Delta")
+	table.SetAlignment(AlignLeft, AlignRight, AlignLeft, AlignRight)
+	table.AddRow("wide", "x", "y")
+	table.SetColSpans(0, 2, 1, 1)
+	table.AddRow("1", "2", "3", "4")
+	table.SetRowLines(false)
+	table.SetAvailableWidth(30)
+	table.SetSplitColumns(true)
+	table.Render()
+	// alignments are set by cell position, so y is aligned as the third cell of its row
+	assertMultilineEqual(t, `
+┌───────┬──────┬───────┐
+│ Alpha │ Beta │ Gamma │
+├───────┴──────┼───────┤
+│ wide         │     x │
+│ 1     │    2 │ 3     │
+└───────┴──────┴───────┘
+
+┌───────┐
+│ Delta │
+├───────┤
+│ y     │
+│     4 │
+└───────┘
+`, "\n"+builder.String())
+}
@@ -0,0 +1,80 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetRowStyles sets styles which are applied to rows of data in turn, such as alternating background colours, to make
+// rows easier to follow across wide tables. The style covers the padding as well as the content of each cell, and
+// every line of rows which are wrapped. Cells which are merged by SetAutoMerge keep the style of the row they start in.
+func (t *Table) SetRowStyles(styles ...Style) {
+	t.rowStyles = styles
+}
+
+// SetZebra alternates rows of data between the normal style and the given style, e.g. StyleBackgroundBrightBlack.
+func (t *Table) SetZebra(style Style) {
+	t.SetRowStyles(StyleNormal, style)
+}
+
+// applyRowStyles sets the style of each cell of data, with merged cells continuing the style of the cell above
+func (t *Table) applyRowStyles(formatted []iRow) []iRow {
+	if len(t.rowStyles) == 0 {
+		return formatted
+	}
+	var styles []Style
+	var index int
+	for r, row := range formatted {
+		if row.header || row.footer {
+			continue
+		}
+		style := t.rowStyles[index%len(t.rowStyles)]
+		index++
+		var relative int
+		for c, col := range row.cols {
+			cellStyle := style
+			if col.mergeAbove && relative < len(styles) {
+				cellStyle = styles[relative]
+			}
+			for relative+col.span > len(styles) {
+				styles = append(styles, StyleNormal)
+			}
+			for i := relative; i < relative+col.span; i++ {
+				styles[i] = cellStyle
+			}
+			formatted[r].cols[c].style = cellStyle
+			relative += col.span
+		}
+	}
+	return formatted
+}
+
+// withRowStyle re-applies a row style after each SGR sequence in a line which resets styles, so the row style
+// continues to the end of the cell
+func withRowStyle(line string, style Style) string {
+	if style == StyleNormal || !strings.Contains(line, "\x1b[") {
+		return line
+	}
+	apply := fmt.Sprintf("\x1b[%dm", style)
+	var output strings.Builder
+	for i := 0; i < len(line); {
+		if line[i] != 0x1b {
+			output.WriteByte(line[i])
+			i++
+			continue
+		}
+		length, kind := escapeLength(line[i:])
+		if length == 0 {
+			output.WriteString(line[i:])
+			break
+		}
+		sequence := line[i : i+length]
+		output.WriteString(sequence)
+		i += length
+		// a sequence which resets styles discards whatever was active before it
+		if kind == escapeSGR && isStyleReset(sequence) {
+			output.WriteString(apply)
+		}
+	}
+	return output.String()
+}
@@ -0,0 +1,83 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Zebra(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "Notes")
+	table.AddRow("a", "one")
+	table.AddRow("b", "two\nthree")
+	table.AddRow("c", "four")
+	table.SetRowLines(false)
+	table.SetZebra(StyleBackgroundBlue)
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────┬───────┐
+│ Name │ Notes │
+├──────┼───────┤
+│ a    │ one   │
+│\x1b[44m b    \x1b[0m│\x1b[44m two   \x1b[0m│
+│\x1b[44m      \x1b[0m│\x1b[44m three \x1b[0m│
+│ c    │ four  │
+└──────┴───────┘
+`, "\n"+strings.ReplaceAll(builder.String(), "\x1b", `\x1b`))
+}
+
+func Test_RowStylesAutoMerge(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Group", "Item")
+	table.AddRow("x", "1")
+	table.AddRow("x", "2")
+	table.AddRow("y", "3")
+	table.SetAutoMerge(true)
+	table.SetRowLines(false)
+	table.SetRowStyles(StyleDim, StyleNormal)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────┬──────┐
+│ Group │ Item │
+├───────┼──────┤
+│\x1b[2m x     \x1b[0m│\x1b[2m 1    \x1b[0m│
+│\x1b[2m       \x1b[0m│ 2    │
+│\x1b[2m y     \x1b[0m│\x1b[2m 3    \x1b[0m│
+└───────┴──────┘
+`, "\n"+strings.ReplaceAll(builder.String(), "\x1b", `\x1b`))
+}
+
+func Test_WithRowStyle(t *testing.T) {
+	assert.Equal(t, "plain", withRowStyle("plain", StyleDim))
+	assert.Equal(t, "\x1b[31mred\x1b[0m\x1b[44m", withRowStyle("\x1b[31mred\x1b[0m", StyleBackgroundBlue))
+	assert.Equal(t, "\x1b[0;1m\x1b[2mbold", withRowStyle("\x1b[0;1mbold", StyleDim))
+	assert.Equal(t, "\x1b[31mred\x1b[0m", withRowStyle("\x1b[31mred\x1b[0m", StyleNormal))
+}
+
+func Test_RowStylesMarkdown(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A")
+	table.AddRow("1")
+	table.AddRow("2")
+	table.SetZebra(StyleBackgroundBlue)
+	table.SetFormat(FormatMarkdown)
+	table.Render()
+	assert.NotContains(t, builder.String(), "\x1b")
+}
+
+func Test_RowStylesWithLineStyle(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.AddRow("a", "b")
+	table.SetBorders(false)
+	table.SetRowStyles(StyleBackgroundBlue)
+	table.SetLineStyle(StyleRed)
+	table.Render()
+	// the row style is reset before the divider, rather than combined with the line style
+	assert.Equal(t, `\x1b[44m a \x1b[0m\x1b[31m│\x1b[0m\x1b[44m b \x1b[0m`+"\n", strings.ReplaceAll(builder.String(), "\x1b", `\x1b`))
+}
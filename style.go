@@ -26,4 +26,22 @@ const (
 	StyleBrightMagenta Style = 95
 	StyleBrightCyan    Style = 96
 	StyleBrightWhite   Style = 97
+
+	StyleBackgroundBlack   Style = 40
+	StyleBackgroundRed     Style = 41
+	StyleBackgroundGreen   Style = 42
+	StyleBackgroundYellow  Style = 43
+	StyleBackgroundBlue    Style = 44
+	StyleBackgroundMagenta Style = 45
+	StyleBackgroundCyan    Style = 46
+	StyleBackgroundWhite   Style = 47
+
+	StyleBackgroundBrightBlack   Style = 100
+	StyleBackgroundBrightRed     Style = 101
+	StyleBackgroundBrightGreen   Style = 102
+	StyleBackgroundBrightYellow  Style = 103
+	StyleBackgroundBrightBlue    Style = 104
+	StyleBackgroundBrightMagenta Style = 105
+	StyleBackgroundBrightCyan    Style = 106
+	StyleBackgroundBrightWhite   Style = 107
 )
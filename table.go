@@ -1,10 +1,10 @@
 package table
 
 import (
-	"encoding/csv"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	runewidth "github.com/mattn/go-runewidth"
@@ -37,15 +37,43 @@ type Table struct {
 	availableWidth      int
 	headerVerticalAlign Alignment
 	fillWidth           bool
+	pageSize            int
+	pageHeight          int
+	pageFooters         bool
+	pageSeparator       string
+	expandMode          ExpandMode
+	separatedRows       map[int]bool
+	sanitize            SanitizeMode
+	columnLinks         map[int]func(string) string
+	wrapping            []Wrapping
+	tabWidth            int
+	decimalSeparators   []string
+	columns             []*Column
+	namedColumns        map[int]*Column
+	live                bool
+	liveLines           []string
+	headerDividers      *Dividers
+	footerDividers      *Dividers
+	rowLineDividers     *Dividers
+	format              Format
+	splitColumns        bool
+	frozenColumns       int
+	rowNumbers          bool
+	rowNumberStart      int
+	rowNumberHeader     string
+	exportRowNumbers    bool
+	rowStyles           []Style
+	cellSources         map[cellRow][]int
 }
 
 type iRow struct {
-	header bool
-	footer bool
-	cols   []iCol
-	first  bool
-	last   bool
-	height int
+	header    bool
+	footer    bool
+	cols      []iCol
+	first     bool
+	last      bool
+	height    int
+	separated bool
 }
 
 type iCol struct {
@@ -59,6 +87,7 @@ type iCol struct {
 	mergeAbove bool
 	mergeBelow bool
 	alignment  Alignment
+	style      Style
 }
 
 func (c iCol) MaxWidth() int {
@@ -115,9 +144,79 @@ func New(w io.Writer) *Table {
 		footerColspans:      make(map[int][]int),
 		availableWidth:      availableWidth,
 		headerVerticalAlign: AlignTop,
+		pageSeparator:       "\n",
+		tabWidth:            8,
 	}
 }
 
+// clone creates a new table with the same configuration as this one, but without any content
+func (t *Table) clone() *Table {
+	c := *t
+	c.data = nil
+	c.formatted = nil
+	c.headers = nil
+	c.footers = nil
+	c.headerColspans = make(map[int][]int)
+	c.contentColspans = make(map[int][]int)
+	c.footerColspans = make(map[int][]int)
+	c.separatedRows = nil
+	c.namedColumns = nil
+	return &c
+}
+
+// Clone creates a copy of the table, including its content, which can be changed without affecting this table
+func (t *Table) Clone() *Table {
+	c := t.clone()
+	c.data = copyRows(t.data)
+	c.headers = copyRows(t.headers)
+	c.footers = copyRows(t.footers)
+	for _, spans := range []struct {
+		from map[int][]int
+		to   map[int][]int
+	}{
+		{from: t.headerColspans, to: c.headerColspans},
+		{from: t.contentColspans, to: c.contentColspans},
+		{from: t.footerColspans, to: c.footerColspans},
+	} {
+		for r, row := range spans.from {
+			spans.to[r] = append([]int(nil), row...)
+		}
+	}
+	if t.separatedRows != nil {
+		c.separatedRows = make(map[int]bool, len(t.separatedRows))
+		for r, separated := range t.separatedRows {
+			c.separatedRows[r] = separated
+		}
+	}
+	c.alignments = append([]Alignment(nil), t.alignments...)
+	c.headerAlignments = append([]Alignment(nil), t.headerAlignments...)
+	c.footerAlignments = append([]Alignment(nil), t.footerAlignments...)
+	c.wrapping = append([]Wrapping(nil), t.wrapping...)
+	c.decimalSeparators = append([]string(nil), t.decimalSeparators...)
+	c.columnLinks = nil
+	for column, url := range t.columnLinks {
+		c.SetColumnLinks(column, url)
+	}
+	c.columns = nil
+	for _, column := range t.columns {
+		copied := *column
+		c.columns = append(c.columns, &copied)
+	}
+	c.liveLines = nil
+	return c
+}
+
+func copyRows(rows [][]string) [][]string {
+	if rows == nil {
+		return nil
+	}
+	output := make([][]string, len(rows))
+	for r, row := range rows {
+		output[r] = append([]string(nil), row...)
+	}
+	return output
+}
+
 // SetBorders enables/disables the border around the table
 func (t *Table) SetBorders(enabled bool) {
 	t.borders = Borders{
@@ -223,16 +322,38 @@ func (t *Table) AddFooters(footers ...string) {
 // SetPadding sets the minimum number of spaces which must surround each column value (horizontally).
 // For example, a padding of 3 would result in a column value such as "   hello world   " (3 spaces either side)
 func (t *Table) SetPadding(padding int) {
+	if padding < 0 {
+		padding = 0
+	}
 	t.padding = padding
 }
 
 // SetDividers allows customisation of the characters used to draw the table.
 // There are several built-in options, such as UnicodeRoundedDividers.
-// Specifying divider values containing more than 1 rune will result in undefined behaviour.
+// Dividers may be strings of any width: narrower dividers are padded to match the widest one, using EW for
+// horizontal lines and spaces elsewhere.
 func (t *Table) SetDividers(d Dividers) {
 	t.dividers = d
 }
 
+// SetHeaderDividers sets the dividers used for the line between the headers and the rest of the table.
+// Defaults to the dividers set with SetDividers.
+func (t *Table) SetHeaderDividers(d Dividers) {
+	t.headerDividers = &d
+}
+
+// SetFooterDividers sets the dividers used for the line between the footers and the rest of the table.
+// Defaults to the dividers set with SetDividers.
+func (t *Table) SetFooterDividers(d Dividers) {
+	t.footerDividers = &d
+}
+
+// SetRowLineDividers sets the dividers used for the lines between rows, when row lines are enabled.
+// Defaults to the dividers set with SetDividers.
+func (t *Table) SetRowLineDividers(d Dividers) {
+	t.rowLineDividers = &d
+}
+
 // AddRow adds a row to the table. Each argument is a column value.
 func (t *Table) AddRow(cols ...string) {
 	t.data = append(t.data, cols)
@@ -250,36 +371,36 @@ func (t *Table) AddRows(rows ...[]string) {
 
 // SetColumnMaxWidth sets the max column width
 func (t *Table) SetColumnMaxWidth(maxColumnWidth int) {
+	if maxColumnWidth < 1 {
+		maxColumnWidth = 1
+	}
 	t.maxColumnWidth = maxColumnWidth
 }
 
 // LoadCSV loads CSV data from a reader and adds it to the table. Existing rows/headers/footers are retained.
+// Use LoadCSVWithOptions for control over delimiters, comments, multiple header rows etc.
 func (t *Table) LoadCSV(r io.Reader, hasHeaders bool) error {
-	cr := csv.NewReader(r)
-
+	var opts CSVOptions
 	if hasHeaders {
-		headers, err := cr.Read()
-		if err != nil {
-			return err
-		}
-		t.SetHeaders(headers...)
+		opts.HeaderRows = 1
 	}
+	return t.loadCSV(r, opts, true)
+}
 
-	for {
-		data, err := cr.Read()
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			return err
+// getAlignment finds the alignment of a cell, by its index within the row for settings made by position, and by its
+// logical column for columns configured by name
+func (t *Table) getAlignment(header bool, footer bool, row int, colIndex int, relative int) Alignment {
+	if column := t.namedColumn(relative); column != nil {
+		switch {
+		case header && column.headerAlignment != nil:
+			return *column.headerAlignment
+		case footer && column.footerAlignment != nil:
+			return *column.footerAlignment
+		case !header && !footer && column.alignment != nil:
+			return *column.alignment
 		}
-		t.AddRow(data...)
 	}
-
-	return nil
-}
-
-func (t *Table) getAlignment(colIndex int, header bool, footer bool) Alignment {
+	colIndex = t.sourceCell(header, footer, row, colIndex)
 	switch {
 	case header:
 		if colIndex >= len(t.headerAlignments) {
@@ -348,15 +469,19 @@ func (t *Table) formatData() {
 				first:  i == 0,
 				last:   i == len(t.headers)-1 && len(t.data)+len(t.footers) == 0,
 			}
+			var relative int
 			for j, heading := range headerSet {
+				span := t.getColspan(true, false, i, j)
+				heading = t.cellContent(heading, relative)
 				headerRow.cols = append(headerRow.cols, iCol{
 					original:  heading,
 					width:     runewidth.StringWidth(heading),
 					first:     j == 0,
 					last:      j == maxCols-1,
-					alignment: t.getAlignment(j, true, false),
-					span:      t.getColspan(true, false, i, j),
+					alignment: t.getAlignment(true, false, i, j, relative),
+					span:      span,
 				})
+				relative += span
 			}
 			formatted = append(formatted, headerRow)
 		}
@@ -365,21 +490,31 @@ func (t *Table) formatData() {
 	// add rows
 	for rowIndex, cols := range t.data {
 		fRow := iRow{
-			header: false,
-			footer: false,
-			cols:   nil,
-			first:  rowIndex == 0 && len(formatted) == 0,
-			last:   rowIndex == len(t.data)-1 && len(t.footers) == 0,
+			header:    false,
+			footer:    false,
+			cols:      nil,
+			first:     rowIndex == 0 && len(formatted) == 0,
+			last:      rowIndex == len(t.data)-1 && len(t.footers) == 0,
+			separated: t.separatedRows[rowIndex],
 		}
+		var relative int
 		for colIndex, data := range cols {
+			span := t.getColspan(false, false, rowIndex, colIndex)
+			url := t.columnLink(rowIndex, colIndex, relative, data)
+			data = t.cellContent(data, relative)
+			if url != "" {
+				// the content has already been sanitised, so the link is added unmarked
+				data = hyperlink(url) + data + closeHyperlink
+			}
 			fRow.cols = append(fRow.cols, iCol{
 				original:  data,
 				width:     runewidth.StringWidth(data),
 				first:     colIndex == 0,
 				last:      colIndex == maxCols-1,
-				alignment: t.getAlignment(colIndex, false, false),
-				span:      t.getColspan(false, false, rowIndex, colIndex),
+				alignment: t.getAlignment(false, false, rowIndex, colIndex, relative),
+				span:      span,
 			})
+			relative += span
 		}
 		formatted = append(formatted, fRow)
 	}
@@ -394,15 +529,19 @@ func (t *Table) formatData() {
 				first:  len(formatted) == 0,
 				last:   i == len(t.footers)-1,
 			}
+			var relative int
 			for j, footing := range footerSet {
+				span := t.getColspan(false, true, i, j)
+				footing = t.cellContent(footing, relative)
 				footerRow.cols = append(footerRow.cols, iCol{
 					original:  footing,
 					width:     runewidth.StringWidth(footing),
 					first:     j == 0,
 					last:      j == maxCols-1,
-					alignment: t.getAlignment(j, false, true),
-					span:      t.getColspan(false, true, i, j),
+					alignment: t.getAlignment(false, true, i, j, relative),
+					span:      span,
 				})
+				relative += span
 			}
 			formatted = append(formatted, footerRow)
 		}
@@ -410,13 +549,14 @@ func (t *Table) formatData() {
 
 	formatted = t.equaliseRows(formatted, maxCols)
 	formatted = t.formatContent(formatted)
-	t.formatted = t.mergeContent(formatted)
+	formatted = t.mergeContent(formatted)
+	t.formatted = t.applyRowStyles(formatted)
 }
 
-func (t *Table) calcColumnWidth(rowIndex int, row iRow) int {
+func (t *Table) calcColumnWidth(row iRow) int {
 	rowTotal := 0
-	for c := range row.cols {
-		rowTotal += t.getColspan(row.header, row.footer, rowIndex, c)
+	for _, col := range row.cols {
+		rowTotal += col.span
 	}
 	return rowTotal
 }
@@ -427,7 +567,7 @@ func (t *Table) equaliseRows(formatted []iRow, maxCols int) []iRow {
 		if len(row.cols) > 0 {
 			row.cols[len(row.cols)-1].last = false
 		}
-		for t.calcColumnWidth(i, row) < maxCols {
+		for t.calcColumnWidth(row) < maxCols {
 			row.cols = append(row.cols, iCol{
 				first: len(row.cols) == 0,
 				span:  1,
@@ -443,27 +583,43 @@ func (t *Table) equaliseRows(formatted []iRow, maxCols int) []iRow {
 
 func (t *Table) formatContent(formatted []iRow) []iRow {
 
-	var maxWidth int
-	for _, row := range formatted {
-		rowWidth := 1
-		for _, col := range row.cols {
-			rowWidth += col.width + (t.padding * 2) + 1
+	dw := t.dividerWidth()
+
+	lefts, rights := t.decimalWidths(formatted)
+
+	maxWidth := dw
+	var exceeded bool
+	for c, width := range t.measureColumns(formatted, func(content string, _ int) int {
+		return cellWidth(content)
+	}) {
+		if decimal := lefts[c] + rights[c]; decimal > width {
+			width = decimal
 		}
-		if rowWidth > maxWidth {
-			maxWidth = rowWidth
+		if column := t.namedColumn(c); column != nil && column.maxWidth > 0 && width > column.maxWidth {
+			exceeded = true
 		}
+		maxWidth += width + (t.padding * 2) + dw
+	}
+	enableWrapping := t.availableWidth < maxWidth || exceeded
+
+	var limits []int
+	if enableWrapping {
+		limits = t.wrapLimits(formatted, lefts, rights)
 	}
-	enableWrapping := t.availableWidth < maxWidth
 
 	// wrap text
 	for r, row := range formatted {
 		maxLines := 0
 		for c, col := range row.cols {
-			wrapLen := t.maxColumnWidth
-			if !enableWrapping {
-				wrapLen = runewidth.StringWidth(col.original)
+			wrapLen := runewidth.StringWidth(col.original)
+			if enableWrapping {
+				wrapLen = t.wrapLimit(row, c, limits)
+			}
+			relative := t.getRelativeIndex(row, c)
+			wrapped := wrapText(col.original, wrapLen, t.getWrapping(relative))
+			if col.alignment == AlignDecimal && col.span == 1 {
+				wrapped = alignDecimal(wrapped, t.getDecimalSeparator(relative), lefts[relative], rights[relative])
 			}
-			wrapped := wrapText(col.original, wrapLen)
 			formatted[r].cols[c].lines = wrapped
 			if len(wrapped) > maxLines {
 				maxLines = len(wrapped)
@@ -481,9 +637,9 @@ func (t *Table) formatContent(formatted []iRow) []iRow {
 
 	spares := make([]int, len(formatted))
 	for r, row := range formatted {
-		spare := t.availableWidth - 1
-		for c, col := range row.cols {
-			spare -= col.MaxWidth() + (t.getColspan(row.header, row.footer, r, c) * ((t.padding * 2) + 1))
+		spare := t.availableWidth - dw
+		for _, col := range row.cols {
+			spare -= col.MaxWidth() + (col.span * ((t.padding * 2) + dw))
 		}
 		if spare < 0 {
 			spare = 0
@@ -494,13 +650,14 @@ func (t *Table) formatContent(formatted []iRow) []iRow {
 	var extra int
 
 	// set width of each col, and align text
-	for c := 0; c < t.calcColumnWidth(0, formatted[0]); c++ { // for each col
+	for c := 0; c < t.calcColumnWidth(formatted[0]); c++ { // for each logical col
 
 		// find max width for column across all rows
 		maxWidth := 0
 		for r, row := range formatted {
 
-			if c >= len(row.cols) || row.cols[c].span > 1 {
+			index := t.getRealIndex(row, c)
+			if index >= len(row.cols) || row.cols[index].span > 1 {
 				// ignore columns with a colspan > 1 for now, we'll apply those next
 				continue
 			}
@@ -508,7 +665,7 @@ func (t *Table) formatContent(formatted []iRow) []iRow {
 			if t.fillWidth {
 				extra = spares[r] / len(row.cols)
 			}
-			width := row.cols[c].MaxWidth() + extra
+			width := row.cols[index].MaxWidth() + extra
 			if width > maxWidth {
 				maxWidth = width
 			}
@@ -517,12 +674,13 @@ func (t *Table) formatContent(formatted []iRow) []iRow {
 
 		// set uniform col width, and align all content
 		for r, row := range formatted {
-			if c >= len(row.cols) {
+			index := t.getRealIndex(row, c)
+			if index >= len(row.cols) || row.cols[index].span > 1 {
 				continue
 			}
-			row.cols[c].width = maxWidth
-			for l, line := range row.cols[c].lines {
-				row.cols[c].lines[l] = align(line, maxWidth, row.cols[c].alignment)
+			row.cols[index].width = maxWidth
+			for l, line := range row.cols[index].lines {
+				row.cols[index].lines[l] = align(line, maxWidth, row.cols[index].alignment)
 			}
 			formatted[r] = row
 		}
@@ -531,6 +689,180 @@ func (t *Table) formatContent(formatted []iRow) []iRow {
 	return t.applyColSpans(formatted)
 }
 
+// wrapLimits finds the width each logical column should be wrapped to. The widest columns are narrowed until the
+// table fits within the available width, but never below the longest word in the column, or the width of decimal
+// aligned content.
+func (t *Table) wrapLimits(formatted []iRow, lefts []int, rights []int) []int {
+	natural := t.measureColumns(formatted, func(content string, column int) int {
+		return t.capWidth(cellWidth(content), column)
+	})
+	minimum := t.measureColumns(formatted, t.wordWidth)
+	for c := range natural {
+		// only decimal-aligned columns need room for their digits, and capWidth would otherwise widen every column
+		if lefts[c]+rights[c] == 0 {
+			continue
+		}
+		decimal := t.capWidth(lefts[c]+rights[c], c)
+		if decimal > natural[c] {
+			natural[c] = decimal
+		}
+		if decimal > minimum[c] {
+			minimum[c] = decimal
+		}
+	}
+	gap := (t.padding * 2) + t.dividerWidth()
+
+	limits := natural
+	total := t.dividerWidth()
+	for _, limit := range limits {
+		total += limit + gap
+	}
+	for total > t.availableWidth {
+		widest := -1
+		for c, limit := range limits {
+			if limit > minimum[c] && (widest < 0 || limit > limits[widest]) {
+				widest = c
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		limits[widest]--
+		total--
+	}
+	return limits
+}
+
+// measureColumns measures each logical column using the widest of its cells, widening columns beneath/above spanned
+// cells where they need more room, as applyColSpans will
+func (t *Table) measureColumns(formatted []iRow, measure func(content string, column int) int) []int {
+	type spannedCell struct {
+		start int
+		span  int
+		width int
+	}
+	count := t.calcColumnWidth(formatted[0])
+	widths := make([]int, count)
+	var spanned []spannedCell
+	for _, row := range formatted {
+		for c, col := range row.cols {
+			relative := t.getRelativeIndex(row, c)
+			width := measure(col.original, relative)
+			switch {
+			case col.span > 1:
+				spanned = append(spanned, spannedCell{start: relative, span: col.span, width: width})
+			case relative < count && width > widths[relative]:
+				widths[relative] = width
+			}
+		}
+	}
+	sort.SliceStable(spanned, func(i, j int) bool {
+		return spanned[i].span < spanned[j].span
+	})
+	gap := (t.padding * 2) + t.dividerWidth()
+	for _, cell := range spanned {
+		spreadWidth(widths, cell.start, cell.start+cell.span, cell.width, gap)
+	}
+	return widths
+}
+
+// spreadWidth widens the columns from start to stop where required, so that together with the gaps between them
+// they are at least the given width
+func spreadWidth(widths []int, start int, stop int, width int, gap int) {
+	if stop > len(widths) {
+		stop = len(widths)
+	}
+	if start >= stop {
+		return
+	}
+	available := (stop - start - 1) * gap
+	for i := start; i < stop; i++ {
+		available += widths[i]
+	}
+	excess := width - available
+	if excess <= 0 {
+		return
+	}
+	share := excess / (stop - start)
+	for i := start; i < stop; i++ {
+		widths[i] += share
+	}
+	widths[stop-1] += excess - (share * (stop - start))
+}
+
+// wrapLimit returns the width a cell should be wrapped to, which for spanned cells includes the space between columns
+func (t *Table) wrapLimit(row iRow, index int, limits []int) int {
+	start := t.getRelativeIndex(row, index)
+	span := row.cols[index].span
+	var limit int
+	for i := start; i < start+span && i < len(limits); i++ {
+		limit += limits[i]
+	}
+	limit += (span - 1) * ((t.padding * 2) + t.dividerWidth())
+	if span > 1 {
+		// spanned cells are only limited by the maximum width for the table
+		return t.capWidth(limit, -1)
+	}
+	return t.capWidth(limit, start)
+}
+
+// capWidth limits a width to the maximum width of a logical column
+func (t *Table) capWidth(width int, column int) int {
+	if maxWidth := t.getMaxWidth(column); width > maxWidth {
+		width = maxWidth
+	}
+	if width < 1 {
+		width = 1
+	}
+	return width
+}
+
+// cellWidth returns the width of the widest line of the cell content
+func cellWidth(content string) int {
+	var width int
+	for _, line := range strings.Split(content, "\n") {
+		if w := newANSI(line).Len(); w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// wordWidth returns the narrowest width content can be wrapped to without breaking words, other than those longer
+// than the maximum column width. Characters can never be broken, so the widest character is the lower limit. Words
+// can be broken after punctuation when using WrapPunctuation, so only the widest part of each word is measured, and
+// preformatted content is wrapped by character.
+func (t *Table) wordWidth(content string, column int) int {
+	wrapping := t.getWrapping(column)
+	var width, widest int
+	for _, word := range strings.Fields(newANSI(content).Strip()) {
+		parts := []ansiBlob{newANSI(word)}
+		switch {
+		case wrapping&WrapPreformatted != 0:
+			parts = nil
+		case wrapping&WrapPunctuation != 0:
+			parts = splitPunctuation(parts[0])
+		}
+		for _, part := range parts {
+			if w := part.Len(); w > width {
+				width = w
+			}
+		}
+		for _, r := range word {
+			if w := runewidth.RuneWidth(r); w > widest {
+				widest = w
+			}
+		}
+	}
+	if maxWidth := t.getMaxWidth(column); width > maxWidth {
+		width = maxWidth
+	}
+	if widest > width {
+		width = widest
+	}
+	return width
+}
+
 func (t *Table) alignVertically(lines []ansiBlob, alignment Alignment, maxLines int) []ansiBlob {
 	switch alignment {
 	case AlignBottom:
@@ -565,119 +897,58 @@ func (t *Table) getRelativeIndex(row iRow, index int) int {
 	return relative
 }
 
+// applyColSpans sizes cells which span several columns. Columns are widened where a spanned cell needs more room than
+// the columns beneath/above it, then every cell is set to the combined width of the columns it covers.
 func (t *Table) applyColSpans(formatted []iRow) []iRow {
-	type colSpanJob struct {
-		row         int
-		relativeCol int
-		span        int
-	}
-	var jobs []colSpanJob
-	for i, row := range formatted {
-		for j, col := range row.cols {
-			if col.span > 1 {
-				jobs = append(jobs, colSpanJob{
-					row:         i,
-					relativeCol: t.getRelativeIndex(row, j),
-					span:        col.span,
-				})
-			}
-		}
+	type spannedCell struct {
+		row   int
+		index int
 	}
-	for _, job := range jobs {
 
-		realTargetColIndex := t.getRealIndex(formatted[job.row], job.relativeCol)
-
-		// grab the cell that has a col span applied
-		target := formatted[job.row].cols[realTargetColIndex]
-
-		// calculate the width required for this cell
-		targetWidth := target.MaxWidth()
-
-		// calculate width required to render the cells beneath/above this cell - plus padding/dividers between them
-		var childrenWidth int
-		for i, row := range formatted {
-			if i == job.row { // skip the row we're working on
+	count := t.calcColumnWidth(formatted[0])
+	widths := make([]int, count)
+	var spanned []spannedCell
+	for r, row := range formatted {
+		for c, col := range row.cols {
+			relative := t.getRelativeIndex(row, c)
+			if col.span > 1 {
+				spanned = append(spanned, spannedCell{row: r, index: c})
 				continue
 			}
-			var rowWidth int
-			start := t.getRealIndex(row, job.relativeCol)
-			stop := t.getRealIndex(row, job.relativeCol+job.span)
-			for j := start; j < stop; j++ {
-				rowWidth += row.cols[j].width
-			}
-			if rowWidth > childrenWidth {
-				childrenWidth = rowWidth
-			}
-		}
-		childrenWidth += (job.span - 1) * (1 + (2 * t.padding))
-
-		switch {
-		case childrenWidth == targetWidth:
-			// everything is somehow magically fine
-			target.width = childrenWidth
-			formatted[job.row].cols[realTargetColIndex] = target
-		case childrenWidth > targetWidth:
-			// we need to grow our target cell to make it aligned with the children
-			for i := range target.lines {
-				target.lines[i] = align(target.lines[i], childrenWidth, target.alignment)
-			}
-			target.width = childrenWidth
-			formatted[job.row].cols[realTargetColIndex] = target
-		default:
-			// we need to extend the children to align with the wide cell
-			// we can do this by sharing the extra space between them
-			available := targetWidth - childrenWidth
-			share := available / job.span
-			remainder := available - (share * (job.span - 1))
-
-			// allocate each child some room
-			for i, row := range formatted {
-				if i == job.row { // skip the row we're working on
-					continue
-				}
-				start := t.getRealIndex(row, job.relativeCol)
-				stop := t.getRealIndex(row, job.relativeCol+job.span)
-				for j := start; j < stop; j++ {
-					amount := share
-					if j == stop-1 {
-						amount = remainder
-					}
-					row.cols[j].width += amount
-					for k, line := range row.cols[j].lines {
-						row.cols[j].lines[k] = align(line, row.cols[j].width, row.cols[j].alignment)
-					}
-				}
-				formatted[i] = row
+			if relative < count && col.width > widths[relative] {
+				widths[relative] = col.width
 			}
-			target.width = targetWidth
-			formatted[job.row].cols[realTargetColIndex] = target
 		}
 	}
 
-	var lastColMaxWidth int
-	for r, row := range formatted {
-		col := row.cols[len(row.cols)-1]
-		if t.getColspan(row.header, row.footer, r, len(row.cols)-1) > 1 {
-			continue
-		}
-		width := col.width
-		if width > lastColMaxWidth {
-			lastColMaxWidth = width
-		}
+	// share out any extra room needed by spanned cells, starting with the narrowest spans
+	sort.SliceStable(spanned, func(i, j int) bool {
+		return formatted[spanned[i].row].cols[spanned[i].index].span < formatted[spanned[j].row].cols[spanned[j].index].span
+	})
+	gap := t.dividerWidth() + (2 * t.padding)
+	for _, cell := range spanned {
+		row := formatted[cell.row]
+		start := t.getRelativeIndex(row, cell.index)
+		spreadWidth(widths, start, start+row.cols[cell.index].span, row.cols[cell.index].MaxWidth(), gap)
 	}
+
 	for r, row := range formatted {
-		c := len(row.cols) - 1
-		if t.getColspan(row.header, row.footer, r, c) > 1 {
-			continue
-		}
-		width := row.cols[c].width
-		if width < lastColMaxWidth {
-			row.cols[c].width = lastColMaxWidth
-			for k, line := range row.cols[c].lines {
-				row.cols[c].lines[k] = align(line, lastColMaxWidth, row.cols[c].alignment)
+		for c, col := range row.cols {
+			start := t.getRelativeIndex(row, c)
+			width := (col.span - 1) * gap
+			for i := start; i < start+col.span && i < count; i++ {
+				width += widths[i]
 			}
-			formatted[r] = row
+			if width == col.width && col.span == 1 {
+				continue
+			}
+			for k, line := range col.lines {
+				col.lines[k] = align(line, width, col.alignment)
+			}
+			col.width = width
+			row.cols[c] = col
 		}
+		formatted[r] = row
 	}
 
 	return formatted
@@ -685,9 +956,13 @@ func (t *Table) applyColSpans(formatted []iRow) []iRow {
 
 func (t *Table) mergeContent(formatted []iRow) []iRow {
 
-	columnCount := t.calcColumnWidth(0, formatted[0])
+	columnCount := t.calcColumnWidth(formatted[0])
 	lastValues := make([]string, columnCount)
 	lastIndexes := make([]int, columnCount)
+	lastRows := make([]int, columnCount)
+	for i := range lastRows {
+		lastRows[i] = -1
+	}
 
 	// flag cols as mergeAbove where content matches and is non-empty
 	for c := 0; c < columnCount; c++ {
@@ -695,17 +970,15 @@ func (t *Table) mergeContent(formatted []iRow) []iRow {
 		var allowed bool
 		for r, row := range formatted {
 			// don't merge columns with colspan > 1
-			if t.getColspan(row.header, row.footer, r, c) > 1 {
-				continue
-			}
-			if c >= len(row.cols) {
+			if c >= len(row.cols) || row.cols[c].span > 1 {
 				continue
 			}
 			relativeIndex := t.getRelativeIndex(row, c)
 			allowed = (row.header && t.autoMergeHeaders) || (!row.header && !row.footer && !prevHeader && t.autoMerge)
 			prevHeader = row.header
 			current := row.cols[c].original
-			merge := current == lastValues[relativeIndex] && strings.TrimSpace(current) != ""
+			// only merge with the cell directly above
+			merge := r > 0 && lastRows[relativeIndex] == r-1 && current == lastValues[relativeIndex] && strings.TrimSpace(current) != ""
 			row.cols[c].mergeAbove = merge && allowed
 			if merge && allowed {
 				lastIndex := lastIndexes[relativeIndex]
@@ -717,6 +990,7 @@ func (t *Table) mergeContent(formatted []iRow) []iRow {
 				}
 			}
 			lastValues[relativeIndex] = current
+			lastRows[relativeIndex] = r
 			lastIndexes[relativeIndex] = c
 			formatted[r] = row
 		}
@@ -726,8 +1000,12 @@ func (t *Table) mergeContent(formatted []iRow) []iRow {
 }
 
 func (t *Table) renderRows() {
+	t.renderRowSet(t.formatted)
+}
+
+func (t *Table) renderRowSet(rows []iRow) {
 	var lastRow iRow
-	for _, row := range t.formatted {
+	for _, row := range rows {
 		t.renderRow(row, lastRow)
 		lastRow = row
 	}
@@ -735,13 +1013,20 @@ func (t *Table) renderRows() {
 
 func (t *Table) renderRow(row iRow, prev iRow) {
 	t.renderLineAbove(row, prev)
+	t.renderRowContent(row)
+	t.renderLineBelow(row)
+}
+
+// renderRowContent renders the lines of a row, without the lines between rows
+func (t *Table) renderRowContent(row iRow) {
+	dw := t.dividerWidth()
 	for y := 0; y < row.height; y++ {
 		if t.borders.Left {
 			t.setStyle(t.lineStyle)
-			t.print(t.dividers.NS)
-			t.resetStyle()
+			t.print(padDivider(t.dividers.NS, dw, " "))
 		}
 		for _, col := range row.cols {
+			t.setStyle(col.style)
 			if t.padding > 0 {
 				t.print(strings.Repeat(" ", t.padding))
 			}
@@ -753,24 +1038,26 @@ func (t *Table) renderRow(row iRow, prev iRow) {
 				if row.header {
 					t.setStyle(t.headerStyle)
 				}
-				t.print(col.lines[y].String())
+				t.print(withRowStyle(col.lines[y].String(), col.style))
 				if row.header {
-					t.resetStyle()
+					t.setStyle(col.style)
 				}
 			}
 			if t.padding > 0 {
 				t.print(strings.Repeat(" ", t.padding))
 			}
-			if t.borders.Right || !col.last {
+			switch {
+			case !col.last:
+				t.setStyle(t.lineStyle)
+				t.print(padDivider(t.dividers.NS, dw, " "))
+			case t.borders.Right:
 				t.setStyle(t.lineStyle)
-				t.print(t.dividers.NS)
-				t.resetStyle()
+				t.print(padDividerLeft(t.dividers.NS, dw, " "))
 			}
 		}
+		t.resetStyle()
 		t.print("\n")
 	}
-
-	t.renderLineBelow(row)
 }
 
 // SetHeaderColSpans sets a column span for each column in the given header row.
@@ -815,12 +1102,19 @@ func (t *Table) getColspan(header bool, footer bool, row int, col int) int {
 // renders the line above a row
 func (t *Table) renderLineAbove(row iRow, prev iRow) {
 
-	// don't draw top border if disabled
-	if (row.first && !t.borders.Top) ||
-		(!prev.header && !row.footer && !t.rowLines && !row.first) {
+	if !t.hasLineAbove(row, prev) {
 		return
 	}
 
+	d := t.lineDividers(row, prev)
+	dw := t.dividerWidth()
+	junction := func(s string) string {
+		return padDivider(s, dw, d.EW)
+	}
+
+	prevDividers := t.dividerOffsets(prev)
+	var offset int
+
 	t.setStyle(t.lineStyle)
 	for i, col := range row.cols {
 
@@ -844,48 +1138,109 @@ func (t *Table) renderLineAbove(row iRow, prev iRow) {
 		case col.first && !t.borders.Left:
 			// hide border
 		case row.first && col.first:
-			t.print(t.dividers.ES)
+			t.print(junction(d.ES))
 		case row.first:
-			t.print(t.dividers.ESW)
+			t.print(junction(d.ESW))
 		case col.first && col.mergeAbove:
-			t.print(t.dividers.NS)
+			t.print(padDivider(d.NS, dw, " "))
 		case col.first:
-			t.print(t.dividers.NES)
+			t.print(junction(d.NES))
 		case col.mergeAbove && prevIsMerged:
-			t.print(t.dividers.NS)
+			t.print(padDivider(d.NS, dw, " "))
 		case col.mergeAbove && !aboveIsSpanned:
-			t.print(t.dividers.NSW)
+			t.print(junction(d.NSW))
 		case col.mergeAbove:
-			t.print(t.dividers.SW)
+			t.print(junction(d.SW))
 		case prevIsMerged && !aboveIsSpanned:
-			t.print(t.dividers.NES)
+			t.print(junction(d.NES))
 		case prevIsMerged:
-			t.print(t.dividers.ES)
+			t.print(junction(d.ES))
 		case aboveIsSpanned:
-			t.print(t.dividers.ESW)
+			t.print(junction(d.ESW))
 		default:
-			t.print(t.dividers.ALL)
+			t.print(junction(d.ALL))
 		}
+		width := col.width + (t.padding * 2)
 		if col.mergeAbove {
-			t.print(strings.Repeat(" ", col.width+(t.padding*2)))
+			t.print(strings.Repeat(" ", width))
 		} else {
-			t.print(strings.Repeat(t.dividers.EW, col.width+(t.padding*2)))
+			// draw junctions where the row above has dividers within a spanned cell
+			start := offset
+			for x := offset; x < offset+width; x++ {
+				if dw > 0 && prevDividers[x] && x+dw <= offset+width {
+					t.print(fillDivider(d.EW, x-start))
+					t.print(junction(d.NEW))
+					x += dw - 1
+					start = x + 1
+				}
+			}
+			t.print(fillDivider(d.EW, offset+width-start))
 		}
+		offset += width + dw
 		switch {
 		case col.last && !t.borders.Right:
 			// hide border
 		case col.last && row.first:
-			t.print(t.dividers.SW)
+			t.print(padDividerLeft(d.SW, dw, d.EW))
 		case col.last && col.mergeAbove:
-			t.print(t.dividers.NS)
+			t.print(padDividerLeft(d.NS, dw, " "))
 		case col.last:
-			t.print(t.dividers.NSW)
+			t.print(padDividerLeft(d.NSW, dw, d.EW))
 		}
 	}
 	t.resetStyle()
 	t.print("\n")
 }
 
+// lineDividers finds the set of dividers to use for the line drawn between two rows
+func (t *Table) lineDividers(row iRow, prev iRow) Dividers {
+	switch {
+	case row.first:
+		return t.dividers
+	case prev.header && !row.header && t.headerDividers != nil:
+		return *t.headerDividers
+	case row.footer && !prev.footer && t.footerDividers != nil:
+		return *t.footerDividers
+	case !prev.header && !row.header && !row.footer && t.rowLineDividers != nil:
+		return *t.rowLineDividers
+	default:
+		return t.dividers
+	}
+}
+
+// dividerWidth finds the display width of the widest divider in use, which all other dividers are padded to
+func (t *Table) dividerWidth() int {
+	width := t.dividers.width()
+	for _, d := range []*Dividers{t.headerDividers, t.footerDividers, t.rowLineDividers} {
+		if d != nil && d.width() > width {
+			width = d.width()
+		}
+	}
+	return width
+}
+
+// dividerOffsets finds the position of each divider between the cells of a row, relative to the left border
+func (t *Table) dividerOffsets(row iRow) map[int]bool {
+	dw := t.dividerWidth()
+	offsets := make(map[int]bool)
+	var offset int
+	for _, col := range row.cols {
+		offset += col.width + (t.padding * 2)
+		if !col.last {
+			offsets[offset] = true
+		}
+		offset += dw
+	}
+	return offsets
+}
+
+// hasLineAbove checks whether a line is drawn above a row
+func (t *Table) hasLineAbove(row iRow, prev iRow) bool {
+	// don't draw top border if disabled
+	return !(row.first && !t.borders.Top) &&
+		(prev.header || row.footer || t.rowLines || row.first || row.separated)
+}
+
 // renders the line below a row, if required
 func (t *Table) renderLineBelow(row iRow) {
 	// we only draw lines below the last row (if borders are on)
@@ -893,19 +1248,21 @@ func (t *Table) renderLineBelow(row iRow) {
 		return
 	}
 
+	dw := t.dividerWidth()
+
 	t.setStyle(t.lineStyle)
 	for _, col := range row.cols {
 		switch {
 		case col.first && !t.borders.Left:
 			// hide
 		case col.first:
-			t.print(t.dividers.NE)
+			t.print(padDivider(t.dividers.NE, dw, t.dividers.EW))
 		default:
-			t.print(t.dividers.NEW)
+			t.print(padDivider(t.dividers.NEW, dw, t.dividers.EW))
 		}
-		t.print(strings.Repeat(t.dividers.EW, col.width+(t.padding*2)))
+		t.print(fillDivider(t.dividers.EW, col.width+(t.padding*2)))
 		if col.last && t.borders.Right {
-			t.print(t.dividers.NW)
+			t.print(padDividerLeft(t.dividers.NW, dw, t.dividers.EW))
 		}
 	}
 	t.resetStyle()
@@ -920,8 +1277,12 @@ func (t *Table) resetStyle() {
 	t.setStyle(StyleNormal)
 }
 
+// setStyle switches to the given style, resetting the current style first so that they aren't combined
 func (t *Table) setStyle(s Style) {
 	if s != t.cursorStyle {
+		if t.cursorStyle != StyleNormal && s != StyleNormal {
+			_, _ = fmt.Fprint(t.w, resetStyle)
+		}
 		_, _ = fmt.Fprintf(t.w, "\x1b[%dm", s)
 	}
 	t.cursorStyle = s
@@ -929,13 +1290,50 @@ func (t *Table) setStyle(s Style) {
 
 // Render writes the table to the provider io.Writer
 func (t *Table) Render() {
-	if len(t.headers) == 0 && len(t.footers) == 0 && len(t.data) == 0 {
+	defer t.resolveNamedColumns()()
+	if t.live {
+		t.renderLive()
+		return
+	}
+	if t.hasNoCells() {
+		return
+	}
+	if t.showRowNumbers() {
+		t.numbered().Render()
+		return
+	}
+	switch t.format {
+	case FormatMarkdown:
+		t.markdown().Render()
+		return
+	case FormatHTML:
+		t.renderHTML()
+		return
+	case FormatCSV:
+		t.renderCSV()
+		return
+	}
+	if t.shouldExpand() {
+		t.expanded().Render()
 		return
 	}
 	t.formatData()
+	if t.splitColumns && t.renderedWidth() > t.availableWidth {
+		t.renderSplit()
+		return
+	}
+	if t.isPaged() {
+		t.renderPages()
+		return
+	}
 	t.renderRows()
 }
 
+// hasNoCells checks whether there are no cells to render, across headers, rows and footers
+func (t *Table) hasNoCells() bool {
+	return t.findMaxCols() == 0
+}
+
 // IsEmpty returns if the table has no data
 func (t *Table) IsEmpty() bool {
 	return len(t.data) == 0
@@ -946,7 +1344,9 @@ func (t *Table) RowCount() int {
 	return len(t.data)
 }
 
-// Clear clears the table data
+// Clear clears the table data, along with any column spans set for rows
 func (t *Table) Clear() {
 	t.data = nil
+	t.contentColspans = make(map[int][]int)
+	t.separatedRows = nil
 }
@@ -747,15 +747,15 @@ func Test_HeaderColSpanVariation(t *testing.T) {
 	table.AddRow("eks", "7", "0", "0", "3", "0", "127 hours ago")
 	table.Render()
 	assertMultilineEqual(t, `
-┌─────────┬──────────────────────────────────────────────────┬───────────────┐
-│ Service │                Misconfigurations                 │ Last Scanned  │
-│         ├──────────┬──────────────┬────────┬─────┬─────────┤               │
-│         │ Critical │     High     │ Medium │ Low │ Unknown │               │
-├─────────┼──────────┼──────────────┼────────┼─────┼─────────┼───────────────┤
-│ ec2     │        1 │            2 │      5 │   0 │       3 │ 2 hours ago   │
-│ ecs     │        0 │            - │      - │   1 │       0 │ just now      │
-│ eks     │        7 │            0 │      0 │   3 │       0 │ 127 hours ago │
-└─────────┴──────────┴──────────────┴────────┴─────┴─────────┴───────────────┘
+┌─────────┬──────────────────────────────────────────┬───────────────┐
+│ Service │            Misconfigurations             │ Last Scanned  │
+│         ├──────────┬──────┬────────┬─────┬─────────┤               │
+│         │ Critical │ High │ Medium │ Low │ Unknown │               │
+├─────────┼──────────┼──────┼────────┼─────┼─────────┼───────────────┤
+│ ec2     │        1 │    2 │      5 │   0 │       3 │ 2 hours ago   │
+│ ecs     │        0 │    - │      - │   1 │       0 │ just now      │
+│ eks     │        7 │    0 │      0 │   3 │       0 │ 127 hours ago │
+└─────────┴──────────┴──────┴────────┴─────┴─────────┴───────────────┘
 `, "\n"+builder.String())
 }
 
@@ -775,15 +775,15 @@ func Test_HeaderVerticalAlignBottom(t *testing.T) {
 	table.AddRow("eks", "7", "0", "0", "3", "0", "127 hours ago")
 	table.Render()
 	assertMultilineEqual(t, `
-┌─────────┬──────────────────────────────────────────────────┬───────────────┐
-│         │                Misconfigurations                 │               │
-│         ├──────────┬──────────────┬────────┬─────┬─────────┤               │
-│ Service │ Critical │     High     │ Medium │ Low │ Unknown │ Last Scanned  │
-├─────────┼──────────┼──────────────┼────────┼─────┼─────────┼───────────────┤
-│ ec2     │        1 │            2 │      5 │   0 │       3 │ 2 hours ago   │
-│ ecs     │        0 │            - │      - │   1 │       0 │ just now      │
-│ eks     │        7 │            0 │      0 │   3 │       0 │ 127 hours ago │
-└─────────┴──────────┴──────────────┴────────┴─────┴─────────┴───────────────┘
+┌─────────┬──────────────────────────────────────────┬───────────────┐
+│         │            Misconfigurations             │               │
+│         ├──────────┬──────┬────────┬─────┬─────────┤               │
+│ Service │ Critical │ High │ Medium │ Low │ Unknown │ Last Scanned  │
+├─────────┼──────────┼──────┼────────┼─────┼─────────┼───────────────┤
+│ ec2     │        1 │    2 │      5 │   0 │       3 │ 2 hours ago   │
+│ ecs     │        0 │    - │      - │   1 │       0 │ just now      │
+│ eks     │        7 │    0 │      0 │   3 │       0 │ 127 hours ago │
+└─────────┴──────────┴──────┴────────┴─────┴─────────┴───────────────┘
 `, "\n"+builder.String())
 }
 
@@ -835,6 +835,25 @@ func Test_HeaderColSpanTrivyKubernetesStyleFullWithFillWidth(t *testing.T) {
 `, "\n"+builder.String())
 }
 
+func Test_ColSpanWithHeaders(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("A", "B", "C")
+	table.AddRow("wide", "x")
+	table.AddRow("1", "2", "3")
+	table.SetColSpans(0, 2, 1)
+	table.Render()
+	assertMultilineEqual(t, `
+┌───┬───┬───┐
+│ A │ B │ C │
+├───┴───┼───┤
+│ wide  │ x │
+├───┬───┼───┤
+│ 1 │ 2 │ 3 │
+└───┴───┴───┘
+`, "\n"+builder.String())
+}
+
 func Test_TableIsEmpty(t *testing.T) {
 	builder := &strings.Builder{}
 	table := New(builder)
@@ -872,3 +891,164 @@ func Test_ClearTable(t *testing.T) {
 	table.Clear()
 	assert.Equal(t, true, table.IsEmpty())
 }
+
+func Test_WrapToAvailableWidth(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("ID", "Description")
+	table.SetAvailableWidth(30)
+	table.AddRow("1", "A long description which needs to be wrapped to fit")
+	table.Render()
+	assertMultilineEqual(t, `
+┌────┬────────────────────┐
+│ ID │    Description     │
+├────┼────────────────────┤
+│ 1  │ A long description │
+│    │ which needs to be  │
+│    │ wrapped to fit     │
+└────┴────────────────────┘
+`, "\n"+builder.String())
+}
+
+func Test_OnlyEmptyRows(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.AddRow()
+	table.AddRow()
+	table.Render()
+	assert.Equal(t, "", builder.String())
+}
+
+func Test_NegativePadding(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetPadding(-1)
+	table.AddRow("1", "2")
+	table.Render()
+	assertMultilineEqual(t, `
+┌─┬─┐
+│1│2│
+└─┴─┘
+`, "\n"+builder.String())
+}
+
+func Test_HyperlinksDoNotAffectWidth(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetSanitize(SanitizeNone)
+	table.SetHeaders("ID")
+	table.AddRow("\x1b]8;;https://example.com/CVE-1\x1b\\CVE-1\x1b]8;;\x1b\\")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────┐
+│  ID   │
+├───────┤
+│ `+"\x1b]8;;https://example.com/CVE-1\x1b\\CVE-1\x1b]8;;\x1b\\"+` │
+└───────┘
+`, "\n"+builder.String())
+}
+
+func Test_WrappedStylesPerLine(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetAvailableWidth(25)
+	table.SetAlignment(AlignLeft, AlignCenter, AlignRight)
+	table.AddRow("\x1b[31mred text here\x1b[0m", "\x1b[32mgreen text here\x1b[0m", "\x1b[34mblue text here\x1b[0m")
+	table.Render()
+	assertMultilineEqual(t, `
+┌──────┬───────┬──────┐
+│ \x1b[31mred\x1b[0m  │ \x1b[32mgreen\x1b[0m │ \x1b[34mblue\x1b[0m │
+│ \x1b[31mtext\x1b[0m │ \x1b[32mtext\x1b[0m  │ \x1b[34mtext\x1b[0m │
+│ \x1b[31mhere\x1b[0m │ \x1b[32mhere\x1b[0m  │ \x1b[34mhere\x1b[0m │
+└──────┴───────┴──────┘
+`, "\n"+strings.ReplaceAll(builder.String(), "\x1b", `\x1b`))
+}
+
+func Test_ColumnWrapping(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetAvailableWidth(34)
+	table.SetHeaders("ID", "Path")
+	table.SetWrapping(WrapPunctuation|WrapNoHyphen, WrapPunctuation)
+	table.AddRow("CVE-2021-44228", "/usr/local/lib/libexample.so")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────────┬───────────────┐
+│    ID     │     Path      │
+├───────────┼───────────────┤
+│ CVE-2021- │ /usr/local/   │
+│ 44228     │ lib/          │
+│           │ libexample.so │
+└───────────┴───────────────┘
+`, "\n"+builder.String())
+}
+
+func Test_PreformattedColumn(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetAvailableWidth(30)
+	table.SetHeaders("File", "Snippet")
+	table.SetWrapping(WrapDefault, WrapPreformatted)
+	table.SetTabWidth(4)
+	table.AddRow("deploy.yaml", "spec:\n\tcontainers:\n\t\t- image: nginx:latest  # pinned?\n")
+	table.Render()
+	assertMultilineEqual(t, `
+┌─────────────┬──────────────┐
+│    File     │   Snippet    │
+├─────────────┼──────────────┤
+│ deploy.yaml │ spec:        │
+│             │     containe │
+│             │ rs:          │
+│             │         - im │
+│             │ age: nginx:l │
+│             │ atest  # pin │
+│             │ ned?         │
+└─────────────┴──────────────┘
+`, "\n"+builder.String())
+}
+
+func Test_AlignDecimal(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Name", "Score", "Size", "Time")
+	table.SetAlignment(AlignLeft, AlignDecimal, AlignDecimal, AlignDecimal)
+	table.SetFooterAlignment(AlignLeft, AlignDecimal)
+	table.SetDecimalSeparators("", "", " ", ":")
+	table.AddRow("a", "1.5", "1.5 MiB", "1:05")
+	table.AddRow("b", "12.25", "100 KiB", "12:30")
+	table.AddRow("c", "100", "2 GiB", "0:00:01")
+	table.SetFooters("Total", "113.75", "", "")
+	table.Render()
+	assertMultilineEqual(t, `
+┌───────┬────────┬─────────┬──────────┐
+│ Name  │ Score  │  Size   │   Time   │
+├───────┼────────┼─────────┼──────────┤
+│ a     │   1.5  │ 1.5 MiB │  1:05    │
+├───────┼────────┼─────────┼──────────┤
+│ b     │  12.25 │ 100 KiB │ 12:30    │
+├───────┼────────┼─────────┼──────────┤
+│ c     │ 100    │   2 GiB │  0:00:01 │
+├───────┼────────┼─────────┼──────────┤
+│ Total │ 113.75 │         │          │
+└───────┴────────┴─────────┴──────────┘
+`, "\n"+builder.String())
+}
+
+func Test_AlignDecimalWiderHeader(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetHeaders("Percentage")
+	table.SetAlignment(AlignDecimal)
+	table.AddRow("\x1b[31m5.25\x1b[0m")
+	table.AddRow("10.5")
+	table.Render()
+	assertMultilineEqual(t, `
+┌────────────┐
+│ Percentage │
+├────────────┤
+│       \x1b[31m5.25\x1b[0m │
+├────────────┤
+│      10.5  │
+└────────────┘
+`, "\n"+strings.ReplaceAll(builder.String(), "\x1b", `\x1b`))
+}
@@ -0,0 +1,177 @@
+// Package tabletest provides helpers for testing rendered table output, including golden file comparison.
+//
+// Golden files are stored in the testdata directory of the package under test, and can be created or updated by
+// running the tests with the -tabletest.update flag, which is namespaced to avoid clashing with flags defined by the
+// package under test:
+//
+//	go test ./... -tabletest.update
+package tabletest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aquasecurity/table"
+)
+
+var update = flag.Bool("tabletest.update", false, "update golden files with the actual output")
+
+// Option configures how output is compared
+type Option func(*config)
+
+type config struct {
+	dir        string
+	ignoreANSI bool
+}
+
+// IgnoreANSI removes ANSI escape codes from both the expected and actual output before they are compared, which
+// also keeps failure output readable
+func IgnoreANSI() Option {
+	return func(c *config) {
+		c.ignoreANSI = true
+	}
+}
+
+// WithDir sets the directory golden files are read from and written to. Defaults to "testdata".
+func WithDir(dir string) Option {
+	return func(c *config) {
+		c.dir = dir
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{dir: "testdata"}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// AssertGolden compares the actual output against the golden file testdata/<name>.golden, failing the test if they
+// differ. When the -tabletest.update flag is set, the golden file is written with the actual output instead.
+func AssertGolden(t testing.TB, name string, actual string, opts ...Option) {
+	t.Helper()
+	c := newConfig(opts)
+	path := filepath.Join(c.dir, name+".golden")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create golden file directory: %s", err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %s", err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with -tabletest.update to create it): %s", err)
+	}
+	if message := compare(string(expected), actual, c); message != "" {
+		t.Errorf("output does not match golden file %s:\n%s", path, message)
+	}
+}
+
+// Equal compares the expected output against the actual output, failing the test if they differ
+func Equal(t testing.TB, expected string, actual string, opts ...Option) {
+	t.Helper()
+	if message := compare(expected, actual, newConfig(opts)); message != "" {
+		t.Errorf("output does not match:\n%s", message)
+	}
+}
+
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)`)
+
+func stripANSI(input string) string {
+	return ansiPattern.ReplaceAllString(input, "")
+}
+
+// compare returns a description of the differences between the expected and actual output, or an empty string
+func compare(expected string, actual string, c config) string {
+	if c.ignoreANSI {
+		expected = stripANSI(expected)
+		actual = stripANSI(actual)
+	}
+	if expected == actual {
+		return ""
+	}
+	var message string
+	if cell := cellDiff(expected, actual); cell != "" {
+		message += cell + "\n\n"
+	}
+	return message + lineDiff(expected, actual)
+}
+
+// cellDiff parses both tables and describes the first cell which differs
+func cellDiff(expected string, actual string) string {
+	expectedRows, err := table.ParseRows(strings.NewReader(stripANSI(expected)))
+	if err != nil {
+		return ""
+	}
+	actualRows, err := table.ParseRows(strings.NewReader(stripANSI(actual)))
+	if err != nil {
+		return ""
+	}
+	for r := 0; r < len(expectedRows) && r < len(actualRows); r++ {
+		for c := 0; c < len(expectedRows[r]) || c < len(actualRows[r]); c++ {
+			var want, got string
+			if c < len(expectedRows[r]) {
+				want = expectedRows[r][c]
+			}
+			if c < len(actualRows[r]) {
+				got = actualRows[r][c]
+			}
+			if want != got {
+				return fmt.Sprintf("first differing cell is row %d, column %d: expected %q, got %q", r, c, want, got)
+			}
+		}
+	}
+	if len(expectedRows) != len(actualRows) {
+		return fmt.Sprintf("expected %d rows, got %d", len(expectedRows), len(actualRows))
+	}
+	return ""
+}
+
+// lineDiff lists every line of output, marking lines which only appear in the expected (-) or actual (+) output
+func lineDiff(expected string, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+	var builder strings.Builder
+	for i := 0; i < len(expectedLines) || i < len(actualLines); i++ {
+		var want, got string
+		wantOK := i < len(expectedLines)
+		gotOK := i < len(actualLines)
+		if wantOK {
+			want = expectedLines[i]
+		}
+		if gotOK {
+			got = actualLines[i]
+		}
+		if wantOK && gotOK && want == got {
+			builder.WriteString("  " + visible(want) + "\n")
+			continue
+		}
+		if wantOK {
+			builder.WriteString("- " + visible(want) + "\n")
+		}
+		if gotOK {
+			builder.WriteString("+ " + visible(got) + "\n")
+		}
+	}
+	return builder.String()
+}
+
+// visible makes whitespace and escape characters visible, and marks the end of the line
+func visible(line string) string {
+	line = strings.ReplaceAll(line, "\x1b", "␛")
+	line = strings.ReplaceAll(line, "\t", "→")
+	line = strings.ReplaceAll(line, "\r", "␍")
+	trimmed := strings.TrimRight(line, " ")
+	return trimmed + strings.Repeat("·", len(line)-len(trimmed)) + "$"
+}
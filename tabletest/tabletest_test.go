@@ -0,0 +1,86 @@
+package tabletest
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aquasecurity/table"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder captures failures instead of failing the test
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func render(rows ...[]string) string {
+	builder := &strings.Builder{}
+	t := table.New(builder)
+	t.SetHeaders("ID", "Fruit")
+	for _, row := range rows {
+		t.AddRow(row...)
+	}
+	t.Render()
+	return builder.String()
+}
+
+func Test_AssertGolden(t *testing.T) {
+	AssertGolden(t, "basic", render([]string{"1", "Apple"}, []string{"2", "Banana"}))
+}
+
+func Test_EqualMismatch(t *testing.T) {
+	r := &recorder{TB: t}
+	Equal(r, render([]string{"1", "Apple"}, []string{"2", "Banana"}), render([]string{"1", "Apple"}, []string{"2", "Bananas"}))
+	assert.Len(t, r.failures, 1)
+	assert.Contains(t, r.failures[0], `first differing cell is row 2, column 1: expected "Banana", got "Bananas"`)
+	assert.Contains(t, r.failures[0], "- │ 2  │ Banana │$")
+	assert.Contains(t, r.failures[0], "+ │ 2  │ Bananas │$")
+}
+
+func Test_AssertGoldenMissing(t *testing.T) {
+	if *update {
+		t.Skip("golden files are being updated")
+	}
+	r := &recorder{TB: t}
+	AssertGolden(r, "missing", "")
+	assert.Len(t, r.failures, 1)
+	assert.Contains(t, r.failures[0], "run with -tabletest.update")
+}
+
+func Test_EqualIgnoreANSI(t *testing.T) {
+	r := &recorder{TB: t}
+	Equal(r, "\x1b[31mA\x1b[0m", "A")
+	assert.Len(t, r.failures, 1)
+	assert.Contains(t, r.failures[0], "- ␛[31mA␛[0m$")
+
+	r = &recorder{TB: t}
+	Equal(r, "\x1b[31mA\x1b[0m", "A", IgnoreANSI())
+	assert.Empty(t, r.failures)
+}
+
+func Test_EqualVisibleWhitespace(t *testing.T) {
+	r := &recorder{TB: t}
+	Equal(r, "a\tb  ", "a\tb")
+	assert.Len(t, r.failures, 1)
+	assert.Contains(t, r.failures[0], "- a→b··$")
+	assert.Contains(t, r.failures[0], "+ a→b$")
+}
+
+func Test_UpdateFlagNamespaced(t *testing.T) {
+	// packages under test commonly define their own -update flag
+	assert.Nil(t, flag.Lookup("update"))
+	assert.NotNil(t, flag.Lookup("tabletest.update"))
+}
@@ -1,6 +1,11 @@
 package table
 
-import "strings"
+import (
+	"strings"
+	"unicode/utf8"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
 
 func align(input ansiBlob, width int, a Alignment) ansiBlob {
 	padSize := width - input.Len()
@@ -8,7 +13,7 @@ func align(input ansiBlob, width int, a Alignment) ansiBlob {
 		return input
 	}
 	switch a {
-	case AlignRight:
+	case AlignRight, AlignDecimal:
 		return newANSI(strings.Repeat(" ", padSize) + input.String())
 	case AlignCenter:
 		leftPad := padSize / 2
@@ -25,64 +30,268 @@ func align(input ansiBlob, width int, a Alignment) ansiBlob {
 	}
 }
 
-func wrapText(input string, wrapSize int) []ansiBlob {
-	var words []ansiBlob
-	lines := strings.Split(input, "\n")
-	for _, in := range lines {
-		if len(words) > 0 {
-			words = append(words, ansiBlob{{value: "\n"}})
-		}
-		in = strings.TrimSpace(in)
-		lineWords := newANSI(in).Words()
-		for _, word := range lineWords {
-			// word won't fit on a line by itself, so split it
-			for word.Len() > wrapSize {
-				before, after := word.Cut(wrapSize - 1)
-				word = after
-				words = append(words, newANSI(before.String()+"-"))
+// wrapItem is a word, or part of a word, to be laid out on a line
+type wrapItem struct {
+	word ansiBlob
+	// joined items continue the previous item without a space between them
+	joined bool
+}
+
+func wrapText(input string, wrapSize int, wrapping Wrapping) []ansiBlob {
+	if wrapSize < 1 {
+		wrapSize = 1
+	}
+
+	var output []ansiBlob
+	paragraphs := strings.Split(input, "\n")
+	for p, paragraph := range paragraphs {
+		if wrapping&WrapPreformatted != 0 {
+			// only a trailing new line is dropped
+			if p == len(paragraphs)-1 && paragraph == "" && p > 0 {
+				continue
 			}
-			if word.Len() > 0 {
-				words = append(words, word)
+			output = append(output, wrapCharacters(newANSI(paragraph), wrapSize)...)
+			continue
+		}
+		var indent int
+		if wrapping&WrapPreserveIndent != 0 {
+			indent = len(newANSI(paragraph).Strip()) - len(strings.TrimLeft(newANSI(paragraph).Strip(), " "))
+			// always leave room for at least one character of content
+			if indent >= wrapSize {
+				indent = wrapSize - 1
 			}
 		}
+		items := splitWords(newANSI(strings.TrimSpace(paragraph)).Words(), wrapSize-indent, wrapping)
+		var lines [][]wrapItem
+		if wrapping&WrapBalanced != 0 {
+			lines = balancedLines(items, wrapSize-indent)
+		} else {
+			lines = greedyLines(items, wrapSize-indent)
+		}
+		if len(lines) == 0 {
+			// leading blank lines and a trailing new line are dropped
+			if len(output) == 0 || p == len(paragraphs)-1 {
+				continue
+			}
+			lines = [][]wrapItem{nil}
+		}
+		for _, line := range lines {
+			output = append(output, joinItems(line, indent))
+		}
 	}
 
-	var output []ansiBlob
-	var current ansiBlob
+	if len(output) == 0 {
+		output = append(output, newANSI(""))
+	}
+
+	// re-apply styles and links at the start of each line and close them at the end, so they continue on to the
+	// next line without covering padding/dividers
+	var style, link string
+	for i, line := range output {
+		startStyle, startLink := style, link
+		style, link = line.activeStyle(startStyle), line.hyperlink(startLink)
+		if startStyle == "" && startLink == "" && style == "" && link == "" {
+			continue
+		}
+		content := startStyle + startLink + line.String()
+		if link != "" {
+			content += closeHyperlink
+		}
+		if style != "" {
+			content += resetStyle
+		}
+		output[i] = newANSI(content)
+	}
 
+	return output
+}
+
+// cutIndex finds how many characters from the start of a word fit within the given width, which is always at least one
+func cutIndex(word ansiBlob, width int) int {
+	var count, total int
+	for _, r := range word.Strip() {
+		total += runewidth.RuneWidth(r)
+		if total > width {
+			break
+		}
+		count++
+	}
+	if count == 0 {
+		count = 1
+	}
+	return count
+}
+
+// splitWords breaks words which are wider than the wrap size into parts which fit
+func splitWords(words []ansiBlob, wrapSize int, wrapping Wrapping) []wrapItem {
+	var items []wrapItem
 	for _, word := range words {
+		parts := []ansiBlob{word}
+		if word.Len() > wrapSize && wrapping&WrapPunctuation != 0 {
+			parts = splitPunctuation(word)
+		}
+		for i, part := range parts {
+			joined := i > 0
+			// part won't fit on a line by itself, so split it
+			for part.Len() > wrapSize {
+				var before ansiBlob
+				before, part = cutWord(part, wrapSize, wrapping&WrapNoHyphen == 0)
+				items = append(items, wrapItem{word: before, joined: joined})
+				joined = true
+			}
+			if part.Len() > 0 {
+				items = append(items, wrapItem{word: part, joined: joined})
+			}
+		}
+	}
+	return items
+}
 
-		available := wrapSize - current.Len()
-
-		switch {
-		case word.String() == "\n":
-			output = append(output, current.TrimSpace())
-			current = newANSI("")
-		case available == 0: // no room left on line, start a new one with the word added to it
-			output = append(output, current.TrimSpace())
-			current = word
-			if current.Len() < wrapSize {
-				current = newANSI(current.String() + " ")
+// splitPunctuation splits a word after each path separator or punctuation character
+func splitPunctuation(word ansiBlob) []ansiBlob {
+	var parts []ansiBlob
+	var index int
+	runes := []rune(word.Strip())
+	for i, r := range runes {
+		if i < len(runes)-1 && strings.ContainsRune(wrapBreaks, r) {
+			var part ansiBlob
+			part, word = word.Cut(i + 1 - index)
+			parts = append(parts, part)
+			index = i + 1
+		}
+	}
+	return append(parts, word)
+}
+
+// cutWord cuts the start of a word to fit within the given width, adding a hyphen where there's room for one
+func cutWord(word ansiBlob, width int, hyphen bool) (ansiBlob, ansiBlob) {
+	if !hyphen {
+		return word.Cut(cutIndex(word, width))
+	}
+	before, after := word.Cut(cutIndex(word, width-1))
+	// there may be no room for a hyphen in very narrow columns
+	if before.Len() >= width {
+		return before, after
+	}
+	return newANSI(before.String() + "-"), after
+}
+
+// greedyLines fits as many items as possible on each line in turn
+func greedyLines(items []wrapItem, wrapSize int) [][]wrapItem {
+	var lines [][]wrapItem
+	var line []wrapItem
+	var width int
+	for _, item := range items {
+		gap := 1
+		if len(line) == 0 || item.joined {
+			gap = 0
+		}
+		if len(line) > 0 && width+gap+item.word.Len() > wrapSize {
+			lines = append(lines, line)
+			line, width, gap = nil, 0, 0
+		}
+		line = append(line, item)
+		width += gap + item.word.Len()
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// balancedLines lays out items to minimise the sum of the squares of the space left at the end of each line, other
+// than the last, which evens out the length of the lines
+func balancedLines(items []wrapItem, wrapSize int) [][]wrapItem {
+	n := len(items)
+	if n == 0 {
+		return nil
+	}
+	// costs[i] is the lowest cost of laying out items[i:], which starts a line ending before breaks[i]
+	costs := make([]int, n+1)
+	breaks := make([]int, n+1)
+	for i := n - 1; i >= 0; i-- {
+		costs[i] = -1
+		var width int
+		for j := i; j < n; j++ {
+			if j > i && !items[j].joined {
+				width++
+			}
+			width += items[j].word.Len()
+			// a single item always fits, as long items have already been split
+			if width > wrapSize && j > i {
+				break
+			}
+			var cost int
+			if j < n-1 {
+				cost = (wrapSize - width) * (wrapSize - width)
 			}
-		case current.Len()+word.Len() == wrapSize: // word fits on line exactly, add it
-			current = newANSI(current.String() + word.String())
-		case current.Len()+word.Len() < wrapSize: // word fits on line, add it with a space afterwards
-			current = newANSI(current.String() + word.String() + " ")
-		default: // word won't fit so start a new line
-			output = append(output, current.TrimSpace())
-			current = word
-			if current.Len() < wrapSize {
-				current = newANSI(current.String() + " ")
+			cost += costs[j+1]
+			if costs[i] < 0 || cost < costs[i] {
+				costs[i] = cost
+				breaks[i] = j + 1
 			}
 		}
 	}
-	if current.Len() > 0 {
-		output = append(output, current.TrimSpace())
+	var lines [][]wrapItem
+	for i := 0; i < n; i = breaks[i] {
+		lines = append(lines, items[i:breaks[i]])
 	}
+	return lines
+}
 
-	if len(output) == 0 {
-		output = append(output, newANSI(""))
+// joinItems creates a line from items, separating those which aren't joined with spaces
+func joinItems(items []wrapItem, indent int) ansiBlob {
+	line := strings.Repeat(" ", indent)
+	for i, item := range items {
+		if i > 0 && !item.joined {
+			line += " "
+		}
+		line += item.word.String()
 	}
+	return newANSI(line)
+}
 
-	return output
+// wrapCharacters breaks a line wherever it reaches the wrap size, keeping all whitespace
+func wrapCharacters(line ansiBlob, wrapSize int) []ansiBlob {
+	var output []ansiBlob
+	for line.Len() > wrapSize {
+		var before ansiBlob
+		before, line = line.Cut(cutIndex(line, wrapSize))
+		output = append(output, before)
+	}
+	return append(output, line)
+}
+
+// expandTabs replaces tabs with spaces up to the next tab stop
+func expandTabs(input string, tabWidth int) string {
+	if !strings.ContainsRune(input, '\t') {
+		return input
+	}
+	var output strings.Builder
+	var column int
+	for i := 0; i < len(input); {
+		// escape sequences are copied as they are, to be sanitised along with the rest of the content
+		if input[i] == 0x1b {
+			if length, _ := escapeLength(input[i:]); length > 0 {
+				output.WriteString(input[i : i+length])
+				i += length
+				continue
+			}
+		}
+		r, size := utf8.DecodeRuneInString(input[i:])
+		switch r {
+		case '\t':
+			spaces := tabWidth - column%tabWidth
+			output.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+		case '\n':
+			output.WriteRune(r)
+			column = 0
+		default:
+			output.WriteString(input[i : i+size])
+			column += runewidth.RuneWidth(r)
+		}
+		i += size
+	}
+	return output.String()
 }
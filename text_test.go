@@ -65,13 +65,13 @@ func Test_TextWrapping(t *testing.T) {
 			name:  "ansi codes",
 			input: "\x1b[37mhello this should be\x1b[38mover 4 lines!",
 			wrap:  10,
-			want:  []string{"\x1b[37mhello this", "should", "be\x1b[38mover 4", "lines!"},
+			want:  []string{"\x1b[37mhello this\x1b[0m", "\x1b[37mshould\x1b[0m", "\x1b[37mbe\x1b[38mover 4\x1b[0m", "\x1b[37m\x1b[38mlines!\x1b[0m"},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			output := wrapText(test.input, test.wrap)
+			output := wrapText(test.input, test.wrap, WrapDefault)
 			assert.Equal(t, test.want, blobsToStrings(output))
 		})
 	}
@@ -84,3 +84,104 @@ func blobsToStrings(blobs []ansiBlob) []string {
 	}
 	return output
 }
+
+func Test_WrapTextHyperlink(t *testing.T) {
+	open := "\x1b]8;;https://example.com\x1b\\"
+	lines := wrapText(open+"a long link"+closeHyperlink+" after", 6, WrapDefault)
+	var output []string
+	for _, line := range lines {
+		output = append(output, line.String())
+	}
+	assert.Equal(t, []string{
+		open + "a long" + closeHyperlink,
+		open + "link" + closeHyperlink,
+		"after",
+	}, output)
+}
+
+func Test_WrapTextStyleReset(t *testing.T) {
+	lines := wrapText("\x1b[31mred \x1b[0mplain \x1b[1;32mbold\x1b[0;34m blue", 5, WrapDefault)
+	var got []string
+	for _, line := range lines {
+		got = append(got, line.String())
+	}
+	assert.Equal(t, []string{
+		"\x1b[31mred\x1b[0m",
+		"\x1b[31m\x1b[0mplain",
+		"\x1b[1;32mbold\x1b[0;34m\x1b[0m",
+		"\x1b[0;34mblue\x1b[0m",
+	}, got)
+}
+
+func Test_WrapTextOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wrap     int
+		wrapping Wrapping
+		want     []string
+	}{
+		{
+			name:     "balanced",
+			input:    "aaa bb cc ddddd",
+			wrap:     6,
+			wrapping: WrapBalanced,
+			want:     []string{"aaa", "bb cc", "ddddd"},
+		},
+		{
+			name:     "punctuation",
+			input:    "see /usr/local/bin/thing",
+			wrap:     12,
+			wrapping: WrapPunctuation,
+			want:     []string{"see /usr/", "local/bin/", "thing"},
+		},
+		{
+			name:     "punctuation falls back to hyphen",
+			input:    "CVE-2021-44228-abcdefghijk",
+			wrap:     8,
+			wrapping: WrapPunctuation,
+			want:     []string{"CVE-", "2021-", "44228-", "abcdefg-", "hijk"},
+		},
+		{
+			name:     "no hyphen",
+			input:    "0123456789abcdef",
+			wrap:     6,
+			wrapping: WrapNoHyphen,
+			want:     []string{"012345", "6789ab", "cdef"},
+		},
+		{
+			name:     "preserve indent",
+			input:    "root\n  child one two\n    grandchild",
+			wrap:     10,
+			wrapping: WrapPreserveIndent,
+			want:     []string{"root", "  child", "  one two", "    grand-", "    child"},
+		},
+		{
+			name:     "preformatted",
+			input:    "a  b c\n\n  d\n",
+			wrap:     3,
+			wrapping: WrapPreformatted | WrapBalanced,
+			want:     []string{"a  ", "b c", "", "  d"},
+		},
+		{
+			name:     "styles before indent",
+			input:    "\x1b[31m  red text\x1b[0m",
+			wrap:     6,
+			wrapping: WrapPreserveIndent,
+			want:     []string{"  \x1b[31mred\x1b[0m", "\x1b[31m  text\x1b[0m"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := wrapText(test.input, test.wrap, test.wrapping)
+			assert.Equal(t, test.want, blobsToStrings(output))
+		})
+	}
+}
+
+func Test_ExpandTabs(t *testing.T) {
+	assert.Equal(t, "a   b\n    c", expandTabs("a\tb\n\tc", 4))
+	assert.Equal(t, "日本    x", expandTabs("日本\tx", 4))
+	assert.Equal(t, "\x1b[31ma   b\x1b[0m", expandTabs("\x1b[31ma\tb\x1b[0m", 4))
+}
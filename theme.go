@@ -0,0 +1,196 @@
+package table
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Theme combines dividers, borders, row lines and styles into a single preset which can be applied with SetTheme
+type Theme struct {
+	Name            string
+	Dividers        Dividers
+	HeaderDividers  *Dividers
+	FooterDividers  *Dividers
+	RowLineDividers *Dividers
+	Borders         Borders
+	RowLines        bool
+	LineStyle       Style
+	HeaderStyle     Style
+}
+
+var allBorders = Borders{Left: true, Top: true, Right: true, Bottom: true}
+
+var ThemeUnicode = Theme{
+	Name:     "unicode",
+	Dividers: UnicodeDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+var ThemeRounded = Theme{
+	Name:     "rounded",
+	Dividers: UnicodeRoundedDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+var ThemeASCII = Theme{
+	Name:     "ascii",
+	Dividers: ASCIIDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+var ThemeStar = Theme{
+	Name:     "star",
+	Dividers: StarDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+var ThemeMarkdown = Theme{
+	Name:     "markdown",
+	Dividers: MarkdownDividers,
+	Borders:  Borders{Left: true, Right: true},
+}
+var ThemeDouble = Theme{
+	Name:     "double",
+	Dividers: DoubleDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+var ThemeHeavy = Theme{
+	Name:     "heavy",
+	Dividers: HeavyDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+
+// ThemeHeavyHeader separates the headers from the body with a heavy line, and uses light lines elsewhere
+var ThemeHeavyHeader = Theme{
+	Name:     "heavy-header",
+	Dividers: UnicodeDividers,
+	HeaderDividers: &Dividers{
+		ALL: "┿",
+		NES: "┝",
+		NSW: "┥",
+		NEW: "┷",
+		ESW: "┯",
+		SW:  "┑",
+		ES:  "┍",
+		EW:  "━",
+		NS:  "│",
+	},
+	Borders:     allBorders,
+	RowLines:    true,
+	HeaderStyle: StyleBold,
+}
+var ThemeDashed = Theme{
+	Name:     "dashed",
+	Dividers: DashedDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+var ThemeBlock = Theme{
+	Name:     "block",
+	Dividers: BlockDividers,
+	Borders:  allBorders,
+	RowLines: true,
+}
+
+// ThemeRST renders tables in reStructuredText grid table syntax
+var ThemeRST = Theme{
+	Name:     "rst",
+	Dividers: ASCIIDividers,
+	HeaderDividers: &Dividers{
+		ALL: "+",
+		NES: "+",
+		NSW: "+",
+		NEW: "+",
+		ESW: "+",
+		SW:  "+",
+		ES:  "+",
+		EW:  "=",
+		NS:  "|",
+	},
+	Borders:  allBorders,
+	RowLines: true,
+}
+
+// ThemeOrg renders tables in Emacs Org-mode syntax
+var ThemeOrg = Theme{
+	Name:     "org",
+	Dividers: OrgDividers,
+	Borders:  allBorders,
+}
+
+// ThemeMySQL renders tables in the style of the MySQL command line client
+var ThemeMySQL = Theme{
+	Name:     "mysql",
+	Dividers: ASCIIDividers,
+	Borders:  allBorders,
+}
+
+var themes = struct {
+	sync.RWMutex
+	registered map[string]Theme
+}{
+	registered: make(map[string]Theme),
+}
+
+func init() {
+	for _, theme := range []Theme{
+		ThemeUnicode,
+		ThemeRounded,
+		ThemeASCII,
+		ThemeStar,
+		ThemeMarkdown,
+		ThemeDouble,
+		ThemeHeavy,
+		ThemeHeavyHeader,
+		ThemeDashed,
+		ThemeBlock,
+		ThemeRST,
+		ThemeOrg,
+		ThemeMySQL,
+	} {
+		RegisterTheme(theme)
+	}
+}
+
+// RegisterTheme makes a theme available by name via LookupTheme, replacing any existing theme with the same name.
+// Names are case-insensitive.
+func RegisterTheme(theme Theme) {
+	themes.Lock()
+	defer themes.Unlock()
+	themes.registered[strings.ToLower(theme.Name)] = theme
+}
+
+// LookupTheme finds a registered theme by name
+func LookupTheme(name string) (Theme, bool) {
+	themes.RLock()
+	defer themes.RUnlock()
+	theme, ok := themes.registered[strings.ToLower(name)]
+	return theme, ok
+}
+
+// ThemeNames returns the names of all registered themes, sorted alphabetically
+func ThemeNames() []string {
+	themes.RLock()
+	defer themes.RUnlock()
+	var names []string
+	for name := range themes.registered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetTheme applies the dividers, borders, row lines and styles from the given theme
+func (t *Table) SetTheme(theme Theme) {
+	t.dividers = theme.Dividers
+	t.headerDividers = theme.HeaderDividers
+	t.footerDividers = theme.FooterDividers
+	t.rowLineDividers = theme.RowLineDividers
+	t.borders = theme.Borders
+	t.rowLines = theme.RowLines
+	t.lineStyle = theme.LineStyle
+	t.headerStyle = theme.HeaderStyle
+}
@@ -0,0 +1,92 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ThemeDouble(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetTheme(ThemeDouble)
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.Render()
+	assertMultilineEqual(t, `
+╔═══╦═══╗
+║ A ║ B ║
+╠═══╬═══╣
+║ 1 ║ 2 ║
+╚═══╩═══╝
+`, "\n"+builder.String())
+}
+
+func Test_ThemeRST(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetTheme(ThemeRST)
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.AddRow("3", "4")
+	table.Render()
+	assertMultilineEqual(t, `
++---+---+
+| A | B |
++===+===+
+| 1 | 2 |
++---+---+
+| 3 | 4 |
++---+---+
+`, "\n"+builder.String())
+}
+
+func Test_ThemeOrg(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetTheme(ThemeOrg)
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.AddRow("3", "4")
+	table.Render()
+	assertMultilineEqual(t, `
+|---+---|
+| A | B |
+|---+---|
+| 1 | 2 |
+| 3 | 4 |
+|---+---|
+`, "\n"+builder.String())
+}
+
+func Test_ThemeMarkdown(t *testing.T) {
+	builder := &strings.Builder{}
+	table := New(builder)
+	table.SetTheme(ThemeMarkdown)
+	table.SetHeaders("A", "B")
+	table.AddRow("1", "2")
+	table.AddRow("3", "4")
+	table.Render()
+	assertMultilineEqual(t, `
+| A | B |
+|---|---|
+| 1 | 2 |
+| 3 | 4 |
+`, "\n"+builder.String())
+}
+
+func Test_RegisterTheme(t *testing.T) {
+	RegisterTheme(Theme{
+		Name:     "Custom",
+		Dividers: StarDividers,
+	})
+	theme, ok := LookupTheme("custom")
+	assert.True(t, ok)
+	assert.Equal(t, StarDividers, theme.Dividers)
+	assert.Contains(t, ThemeNames(), "custom")
+	assert.Contains(t, ThemeNames(), "heavy-header")
+
+	_, ok = LookupTheme("missing")
+	assert.False(t, ok)
+}
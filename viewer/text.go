@@ -0,0 +1,161 @@
+package viewer
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const (
+	keyUp        = "up"
+	keyDown      = "down"
+	keyLeft      = "left"
+	keyRight     = "right"
+	keyPageUp    = "pgup"
+	keyPageDown  = "pgdn"
+	keyHome      = "home"
+	keyEnd       = "end"
+	keyEnter     = "enter"
+	keyEscape    = "esc"
+	keyBackspace = "backspace"
+	keyInterrupt = "ctrl-c"
+)
+
+var sequenceKeys = map[string]string{
+	"\x1b[A":  keyUp,
+	"\x1b[B":  keyDown,
+	"\x1b[C":  keyRight,
+	"\x1b[D":  keyLeft,
+	"\x1bOA":  keyUp,
+	"\x1bOB":  keyDown,
+	"\x1bOC":  keyRight,
+	"\x1bOD":  keyLeft,
+	"\x1b[5~": keyPageUp,
+	"\x1b[6~": keyPageDown,
+	"\x1b[H":  keyHome,
+	"\x1b[F":  keyEnd,
+	"\x1bOH":  keyHome,
+	"\x1bOF":  keyEnd,
+	"\x1b[1~": keyHome,
+	"\x1b[4~": keyEnd,
+}
+
+// parseKeys splits input read from the terminal into keys, which are either the names above or a single character
+func parseKeys(input []byte) []string {
+	var keys []string
+	s := string(input)
+	for len(s) > 0 {
+		switch {
+		case strings.HasPrefix(s, "\x1b[") || strings.HasPrefix(s, "\x1bO"):
+			end := 2
+			for end < len(s) && (s[end] < 0x40 || s[end] > 0x7e) {
+				end++
+			}
+			if end == len(s) {
+				// an incomplete sequence
+				return append(keys, keyEscape)
+			}
+			if key, ok := sequenceKeys[s[:end+1]]; ok {
+				keys = append(keys, key)
+			}
+			s = s[end+1:]
+			continue
+		case s[0] == 0x1b:
+			keys = append(keys, keyEscape)
+		case s[0] == '\r' || s[0] == '\n':
+			keys = append(keys, keyEnter)
+		case s[0] == 0x7f || s[0] == 0x08:
+			keys = append(keys, keyBackspace)
+		case s[0] == 0x03:
+			keys = append(keys, keyInterrupt)
+		default:
+			r, size := utf8.DecodeRuneInString(s)
+			keys = append(keys, string(r))
+			s = s[size:]
+			continue
+		}
+		s = s[1:]
+	}
+	return keys
+}
+
+// escapeLength returns the length of the escape sequence at the start of s, or 0 if there isn't one
+func escapeLength(s string) int {
+	if len(s) < 2 || s[0] != 0x1b {
+		return 0
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']', 'P', '_', '^', 'X':
+		for i := 2; i < len(s); i++ {
+			if s[i] == 0x07 {
+				return i + 1
+			}
+			if s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	default:
+		return 2
+	}
+}
+
+// strip removes escape sequences
+func strip(s string) string {
+	var output strings.Builder
+	for i := 0; i < len(s); {
+		if length := escapeLength(s[i:]); length > 0 {
+			i += length
+			continue
+		}
+		output.WriteByte(s[i])
+		i++
+	}
+	return output.String()
+}
+
+func displayWidth(s string) int {
+	return runewidth.StringWidth(strip(s))
+}
+
+// cut returns the part of a line from the left column which fits within the width. Escape sequences are kept, so
+// that styles and links are unaffected, and wide characters which are only partly visible are replaced with spaces.
+func cut(line string, left int, width int) string {
+	var output strings.Builder
+	var column int
+	for i := 0; i < len(line); {
+		if length := escapeLength(line[i:]); length > 0 {
+			output.WriteString(line[i : i+length])
+			i += length
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(line[i:])
+		i += size
+		w := runewidth.RuneWidth(r)
+		start, end := column, column+w
+		column = end
+		switch {
+		case start >= left && end <= left+width:
+			output.WriteRune(r)
+		case end > left && start < left+width:
+			// partly visible
+			visible := w
+			if start < left {
+				visible -= left - start
+			}
+			if end > left+width {
+				visible -= end - (left + width)
+			}
+			output.WriteString(strings.Repeat(" ", visible))
+		}
+	}
+	return output.String()
+}
@@ -0,0 +1,464 @@
+// Package viewer displays a table in the terminal with keyboard controls, for tables which are too large to read as
+// a static dump.
+//
+// The table is rendered by the table package, then scrolled vertically and horizontally with the headers and footers
+// kept in view, along with any frozen columns (see Table.SetFrozenColumns). Rows can be searched, sorted by column
+// and selected:
+//
+//	↑/↓ j/k        select the previous/next row
+//	PgUp/PgDn      select a row a page up/down
+//	Home/End g/G   select the first/last row
+//	←/→ h/l        scroll left/right
+//	/              search, as you type (Enter to finish, Esc to cancel)
+//	n/N            select the next/previous row matching the search
+//	1-9            sort by column, again to reverse the order
+//	0              restore the original order
+//	Enter          select the current row and exit
+//	q/Esc          exit without selecting a row
+package viewer
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aquasecurity/table"
+	"golang.org/x/term"
+)
+
+// scrollStep is the number of columns scrolled horizontally by each key press
+const scrollStep = 4
+
+// Viewer displays a table interactively
+type Viewer struct {
+	table  *table.Table
+	in     io.Reader
+	out    io.Writer
+	width  int
+	height int
+
+	layout     table.Layout
+	order      []int
+	selected   int
+	top        int
+	left       int
+	sortColumn int
+	descending bool
+	searching  bool
+	query      string
+	message    string
+	done       bool
+	chosen     int
+}
+
+// Run displays the table in the terminal using stdin and stdout until the user exits, returning the index of the
+// selected row, or -1 if no row was selected
+func Run(t *table.Table) (int, error) {
+	return New(t, os.Stdin, os.Stdout).Run()
+}
+
+// New creates a viewer for a copy of the table, reading keys from in and drawing to out. The table is rendered
+// without a width limit so that it can be scrolled horizontally, although columns with a maximum width set by name
+// (see table.Column) are still wrapped.
+func New(t *table.Table, in io.Reader, out io.Writer) *Viewer {
+	v := &Viewer{
+		table:      t.Clone(),
+		in:         in,
+		out:        out,
+		width:      80,
+		height:     24,
+		sortColumn: -1,
+		chosen:     -1,
+	}
+	v.table.SetAvailableWidth(math.MaxInt16)
+	v.table.SetFillWidth(false)
+	for i := 0; i < v.table.RowCount(); i++ {
+		v.order = append(v.order, i)
+	}
+	return v
+}
+
+// SetSize sets the size of the viewer, for when the output isn't a terminal. Defaults to 80x24.
+func (v *Viewer) SetSize(width int, height int) {
+	v.width = width
+	v.height = height
+}
+
+// Run displays the table until the user exits, returning the index of the selected row, or -1 if no row was selected
+func (v *Viewer) Run() (int, error) {
+	if f, ok := v.in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return -1, err
+		}
+		defer func() { _ = term.Restore(int(f.Fd()), state) }()
+	}
+
+	// use the alternate screen, so the terminal is left as it was
+	v.print("\x1b[?1049h\x1b[?25l")
+	defer v.print("\x1b[?25h\x1b[?1049l")
+
+	v.resize()
+	v.relayout()
+	buffer := make([]byte, 256)
+	for !v.done {
+		v.resize()
+		v.draw()
+		n, err := v.in.Read(buffer)
+		for _, key := range parseKeys(buffer[:n]) {
+			v.handle(key)
+			if v.done {
+				break
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return -1, err
+		}
+	}
+	return v.chosen, nil
+}
+
+func (v *Viewer) print(s string) {
+	_, _ = fmt.Fprint(v.out, s)
+}
+
+// resize matches the size of the viewer to the terminal, if the output is one
+func (v *Viewer) resize() {
+	f, ok := v.out.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return
+	}
+	if width, height, err := term.GetSize(int(f.Fd())); err == nil {
+		v.width, v.height = width, height
+	}
+}
+
+// relayout renders the table with the rows in their current order
+func (v *Viewer) relayout() {
+	sorted := v.table.Clone()
+	sorted.ReorderRows(v.order)
+	v.layout = sorted.Layout()
+	v.selectRow(v.selected)
+}
+
+// frame decides whether the headers and footers are kept in view, and how many lines of the body are shown
+func (v *Viewer) frame() (bool, bool, int) {
+	// a line is kept for the status bar
+	available := v.height - 1
+	if body := available - v.layout.HeaderLines - v.layout.FooterLines; body > 0 {
+		return true, true, body
+	}
+	if body := available - v.layout.HeaderLines; body > 0 {
+		return true, false, body
+	}
+	if available < 1 {
+		available = 1
+	}
+	return false, false, available
+}
+
+// body returns the lines which are scrolled, and the index of the first of them
+func (v *Viewer) body() ([]string, int) {
+	showHeader, showFooter, _ := v.frame()
+	start, end := 0, len(v.layout.Lines)
+	if showHeader {
+		start = v.layout.HeaderLines
+	}
+	if showFooter {
+		end -= v.layout.FooterLines
+	}
+	return v.layout.Lines[start:end], start
+}
+
+func (v *Viewer) draw() {
+	showHeader, showFooter, height := v.frame()
+	body, start := v.body()
+
+	var output strings.Builder
+	output.WriteString("\x1b[H")
+	writeLine := func(line string, selected bool) {
+		if selected {
+			// keep the row highlighted after any styles in it are reset
+			line = "\x1b[7m" + strings.ReplaceAll(line, "\x1b[0m", "\x1b[0;7m")
+		}
+		output.WriteString(v.visible(line) + "\x1b[0m\x1b[K\r\n")
+	}
+	if showHeader {
+		for _, line := range v.layout.Lines[:v.layout.HeaderLines] {
+			writeLine(line, false)
+		}
+	}
+	for i := 0; i < height; i++ {
+		if v.top+i >= len(body) {
+			output.WriteString("\x1b[K\r\n")
+			continue
+		}
+		writeLine(body[v.top+i], v.isSelected(start+v.top+i))
+	}
+	if showFooter {
+		for _, line := range v.layout.Lines[len(v.layout.Lines)-v.layout.FooterLines:] {
+			writeLine(line, false)
+		}
+	}
+	output.WriteString("\x1b[7m" + cut(v.status(), 0, v.width) + "\x1b[0m\x1b[K")
+	v.print(output.String())
+}
+
+// visible cuts the part of a line which is in view, keeping frozen columns pinned to the left
+func (v *Viewer) visible(line string) string {
+	frozen := v.layout.FrozenWidth
+	if frozen == 0 || frozen >= v.width {
+		return cut(line, v.left, v.width)
+	}
+	return cut(line, 0, frozen) + cut(line, frozen+v.left, v.width-frozen)
+}
+
+func (v *Viewer) isSelected(line int) bool {
+	if v.selected >= len(v.layout.Rows) {
+		return false
+	}
+	row := v.layout.Rows[v.selected]
+	return line >= row.Start && line < row.End
+}
+
+func (v *Viewer) status() string {
+	status := fmt.Sprintf(" Row %d/%d", v.selected+1, len(v.order))
+	if len(v.order) == 0 {
+		status = " No rows"
+	}
+	if v.sortColumn >= 0 {
+		direction := "ascending"
+		if v.descending {
+			direction = "descending"
+		}
+		status += fmt.Sprintf("  Sorted by column %d, %s", v.sortColumn+1, direction)
+	}
+	switch {
+	case v.searching:
+		status += "  /" + v.query
+	case v.query != "":
+		status += "  Search: " + v.query + " (n/N)"
+	}
+	if v.message != "" {
+		status += "  " + v.message
+	}
+	return status + "  q: quit"
+}
+
+func (v *Viewer) handle(key string) {
+	v.message = ""
+	if v.searching {
+		v.handleSearch(key)
+		return
+	}
+	_, _, height := v.frame()
+	switch key {
+	case "q", keyEscape, keyInterrupt:
+		v.done = true
+	case keyEnter:
+		if len(v.order) > 0 {
+			v.chosen = v.order[v.selected]
+		}
+		v.done = true
+	case keyUp, "k":
+		v.selectRow(v.selected - 1)
+	case keyDown, "j":
+		v.selectRow(v.selected + 1)
+	case keyPageUp:
+		v.selectLine(v.rowStart() - height)
+	case keyPageDown:
+		v.selectLine(v.rowStart() + height)
+	case keyHome, "g":
+		v.selectRow(0)
+	case keyEnd, "G":
+		v.selectRow(len(v.order) - 1)
+	case keyLeft, "h":
+		v.scroll(-scrollStep)
+	case keyRight, "l":
+		v.scroll(scrollStep)
+	case "/":
+		v.searching = true
+		v.query = ""
+	case "n":
+		v.search(v.selected+1, 1)
+	case "N":
+		v.search(v.selected-1, -1)
+	case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		v.sort(int(key[0]-'0') - 1)
+	}
+}
+
+func (v *Viewer) handleSearch(key string) {
+	switch key {
+	case keyEnter:
+		v.searching = false
+	case keyEscape:
+		v.searching = false
+		v.query = ""
+	case keyInterrupt:
+		v.done = true
+	case keyBackspace:
+		if runes := []rune(v.query); len(runes) > 0 {
+			v.query = string(runes[:len(runes)-1])
+			v.search(v.selected, 1)
+		}
+	default:
+		if len([]rune(key)) == 1 && key >= " " {
+			v.query += key
+			v.search(v.selected, 1)
+		}
+	}
+}
+
+// rowStart returns the first line of the selected row
+func (v *Viewer) rowStart() int {
+	if v.selected >= len(v.layout.Rows) {
+		return 0
+	}
+	return v.layout.Rows[v.selected].Start
+}
+
+// selectLine selects the row at a line, or the closest one to it
+func (v *Viewer) selectLine(line int) {
+	for i, row := range v.layout.Rows {
+		if row.End > line {
+			v.selectRow(i)
+			return
+		}
+	}
+	v.selectRow(len(v.layout.Rows) - 1)
+}
+
+// selectRow selects a row, scrolling so that it is in view
+func (v *Viewer) selectRow(index int) {
+	if index >= len(v.layout.Rows) {
+		index = len(v.layout.Rows) - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	v.selected = index
+	if len(v.layout.Rows) == 0 {
+		return
+	}
+	_, start := v.body()
+	_, _, height := v.frame()
+	row := v.layout.Rows[index]
+	if row.End-start > v.top+height {
+		v.top = row.End - start - height
+	}
+	if row.Start-start < v.top {
+		v.top = row.Start - start
+	}
+	if v.top < 0 {
+		v.top = 0
+	}
+}
+
+// scroll moves the view horizontally, without scrolling past the right of the table
+func (v *Viewer) scroll(by int) {
+	var widest int
+	for _, line := range v.layout.Lines {
+		if width := displayWidth(line); width > widest {
+			widest = width
+		}
+	}
+	v.left += by
+	if v.left > widest-v.width {
+		v.left = widest - v.width
+	}
+	if v.left < 0 {
+		v.left = 0
+	}
+}
+
+// search selects the first row from the given position in the given direction which contains the query
+func (v *Viewer) search(from int, direction int) {
+	if v.query == "" || len(v.order) == 0 {
+		return
+	}
+	query := strings.ToLower(v.query)
+	n := len(v.order)
+	for i := 0; i < n; i++ {
+		position := ((from+i*direction)%n + n) % n
+		for _, value := range v.table.Row(v.order[position]) {
+			if strings.Contains(strings.ToLower(strip(value)), query) {
+				v.selectRow(position)
+				return
+			}
+		}
+	}
+	v.message = "No match"
+}
+
+// sort orders the rows by a column, reversing the order if they're already sorted by it. A negative column restores
+// the original order, and columns which the table doesn't have are ignored.
+func (v *Viewer) sort(column int) {
+	if column >= v.columns() {
+		return
+	}
+	var current int
+	if len(v.order) > 0 {
+		current = v.order[v.selected]
+	}
+	switch {
+	case column < 0:
+		v.sortColumn = -1
+		v.descending = false
+		sort.Ints(v.order)
+	case column == v.sortColumn:
+		v.descending = !v.descending
+	default:
+		v.sortColumn = column
+		v.descending = false
+	}
+	if v.sortColumn >= 0 {
+		sort.SliceStable(v.order, func(i, j int) bool {
+			a, b := v.table.Cell(v.order[i], v.sortColumn), v.table.Cell(v.order[j], v.sortColumn)
+			if v.descending {
+				return less(b, a)
+			}
+			return less(a, b)
+		})
+	}
+	for position, index := range v.order {
+		if index == current {
+			v.selected = position
+		}
+	}
+	v.relayout()
+}
+
+// columns counts the cells in the widest header or row
+func (v *Viewer) columns() int {
+	var count int
+	for _, header := range v.table.Headers() {
+		if len(header) > count {
+			count = len(header)
+		}
+	}
+	for r := 0; r < v.table.RowCount(); r++ {
+		if cells := len(v.table.Row(r)); cells > count {
+			count = cells
+		}
+	}
+	return count
+}
+
+// less compares values numerically where both are numbers, and alphabetically otherwise
+func less(a string, b string) bool {
+	a, b = strings.TrimSpace(strip(a)), strings.TrimSpace(strip(b))
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+	return strings.ToLower(a) < strings.ToLower(b)
+}
@@ -0,0 +1,159 @@
+package viewer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aquasecurity/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTable() *table.Table {
+	t := table.New(&strings.Builder{})
+	t.SetHeaders("ID", "Severity", "Score")
+	t.AddRow("CVE-1", "HIGH", "7.5")
+	t.AddRow("CVE-2", "LOW", "2")
+	t.AddRow("CVE-3", "CRITICAL", "10")
+	t.AddRow("CVE-4", "MEDIUM", "5.1")
+	return t
+}
+
+func run(t *testing.T, input string) (int, string) {
+	output := &strings.Builder{}
+	v := New(testTable(), strings.NewReader(input), output)
+	v.SetSize(40, 12)
+	selected, err := v.Run()
+	require.NoError(t, err)
+	return selected, output.String()
+}
+
+func Test_Select(t *testing.T) {
+	selected, _ := run(t, "jj\r")
+	assert.Equal(t, 2, selected)
+	selected, _ = run(t, "\x1b[B\x1b[B\x1b[A\r")
+	assert.Equal(t, 1, selected)
+	selected, _ = run(t, "G\r")
+	assert.Equal(t, 3, selected)
+	selected, _ = run(t, "jjq")
+	assert.Equal(t, -1, selected)
+	selected, _ = run(t, "jj")
+	assert.Equal(t, -1, selected)
+}
+
+func Test_Search(t *testing.T) {
+	selected, _ := run(t, "/med\r\r")
+	assert.Equal(t, 3, selected)
+	selected, _ = run(t, "/cve\rnn\r")
+	assert.Equal(t, 2, selected)
+	selected, _ = run(t, "/cve\rN\r")
+	assert.Equal(t, 3, selected)
+	_, output := run(t, "/nothing")
+	assert.Contains(t, output, "No match")
+}
+
+func Test_Sort(t *testing.T) {
+	// numeric sort by score puts CVE-2 first
+	selected, _ := run(t, "3g\r")
+	assert.Equal(t, 1, selected)
+	// reversed, CVE-3 is first
+	selected, _ = run(t, "33g\r")
+	assert.Equal(t, 2, selected)
+	// alphabetical by severity
+	selected, _ = run(t, "2g\r")
+	assert.Equal(t, 2, selected)
+	// the selected row stays selected when sorting
+	selected, _ = run(t, "j3\r")
+	assert.Equal(t, 1, selected)
+	// original order restored
+	selected, _ = run(t, "30g\r")
+	assert.Equal(t, 0, selected)
+}
+
+func Test_FrozenHeaders(t *testing.T) {
+	output := &strings.Builder{}
+	tbl := testTable()
+	for i := 5; i <= 20; i++ {
+		tbl.AddRow("CVE-x", "LOW", "1")
+	}
+	v := New(tbl, strings.NewReader("G"), output)
+	v.SetSize(40, 8)
+	_, err := v.Run()
+	require.NoError(t, err)
+
+	// the last frame drawn shows the headers above the last rows
+	frames := strings.Split(output.String(), "\x1b[H")
+	last := strip(frames[len(frames)-1])
+	lines := strings.Split(last, "\r\n")
+	assert.Equal(t, "│  ID   │ Severity │ Score │", strings.TrimRight(lines[1], " "))
+	assert.Contains(t, lines[5], "CVE-x")
+	assert.Contains(t, lines[6], "└")
+	assert.Contains(t, lines[7], "Row 20/20")
+}
+
+func Test_ParseKeys(t *testing.T) {
+	assert.Equal(t,
+		[]string{keyUp, "a", keyPageDown, keyEnter, keyBackspace, "日", keyEscape},
+		parseKeys([]byte("\x1b[Aa\x1b[6~\r\x7f日\x1b")),
+	)
+	assert.Equal(t, []string{keyEscape}, parseKeys([]byte("\x1b[1")))
+}
+
+func Test_Cut(t *testing.T) {
+	assert.Equal(t, "cde", cut("abcdef", 2, 3))
+	assert.Equal(t, "\x1b[31mcd\x1b[0m", cut("\x1b[31mabcd\x1b[0mef", 2, 2))
+	assert.Equal(t, " 本", cut("日本語", 1, 3))
+	assert.Equal(t, "日 ", cut("日本語", 0, 3))
+}
+
+func Test_ScrollHorizontally(t *testing.T) {
+	output := &strings.Builder{}
+	v := New(testTable(), strings.NewReader("ll"), output)
+	v.SetSize(10, 12)
+	_, err := v.Run()
+	require.NoError(t, err)
+
+	frames := strings.Split(output.String(), "\x1b[H")
+	lines := strings.Split(strip(frames[len(frames)-1]), "\r\n")
+	assert.Equal(t, "│ Severity", lines[1])
+	assert.Equal(t, "│ HIGH    ", lines[3])
+}
+
+func Test_ScrollFrozenColumns(t *testing.T) {
+	tbl := testTable()
+	tbl.SetFrozenColumns(1)
+	output := &strings.Builder{}
+	v := New(tbl, strings.NewReader("ll"), output)
+	v.SetSize(20, 12)
+	_, err := v.Run()
+	require.NoError(t, err)
+
+	frames := strings.Split(output.String(), "\x1b[H")
+	lines := strings.Split(strip(frames[len(frames)-1]), "\r\n")
+	assert.Equal(t, "│  ID   │y │ Score │", lines[1])
+	assert.Equal(t, "│ CVE-1 │  │ 7.5   │", lines[3])
+}
+
+func Test_SortIgnoresMissingColumns(t *testing.T) {
+	selected, output := run(t, "9G\r")
+	assert.Equal(t, 3, selected)
+	assert.NotContains(t, output, "Sorted by column 9")
+}
+
+func Test_SortKeepsColumnSpans(t *testing.T) {
+	tbl := table.New(&strings.Builder{})
+	tbl.SetHeaders("A", "B", "C")
+	tbl.AddRow("b", "x", "y")
+	tbl.AddRow("a wide", "z")
+	tbl.SetColSpans(1, 2, 1)
+	output := &strings.Builder{}
+	v := New(tbl, strings.NewReader("1"), output)
+	v.SetSize(40, 12)
+	_, err := v.Run()
+	require.NoError(t, err)
+
+	frames := strings.Split(output.String(), "\x1b[H")
+	lines := strings.Split(strip(frames[len(frames)-1]), "\r\n")
+	assert.Equal(t, "│ a wide │ z │", lines[3])
+	assert.Equal(t, "│ b │ x  │ y │", lines[5])
+}
@@ -0,0 +1,58 @@
+package table
+
+// Wrapping controls how cell content is wrapped when it is too wide for its column. Options can be combined, e.g.
+// WrapBalanced | WrapPunctuation.
+type Wrapping uint8
+
+// WrapDefault fits as many words as possible on each line, breaking words which are too long with a hyphen
+const WrapDefault Wrapping = 0
+
+const (
+	// WrapBalanced evens out the length of wrapped lines, rather than filling each line in turn
+	WrapBalanced Wrapping = 1 << iota
+	// WrapPunctuation breaks words which are too long after path separators and punctuation where possible, which
+	// suits URLs, file paths and identifiers such as CVE-2021-44228
+	WrapPunctuation
+	// WrapNoHyphen breaks words which are too long without inserting a hyphen
+	WrapNoHyphen
+	// WrapPreserveIndent keeps the leading spaces of each line of content, and indents wrapped lines to match
+	WrapPreserveIndent
+	// WrapPreformatted keeps all whitespace in content, expanding tabs (see SetTabWidth), and wraps lines at the
+	// column width regardless of words. Other wrapping options are ignored.
+	WrapPreformatted
+)
+
+// wrapBreaks are the characters a word can be broken after when using WrapPunctuation
+const wrapBreaks = "/\\-_.,:;?&=@#+|~"
+
+// SetWrapping sets how the content of each column is wrapped. Should be specified for each column in the supplied data.
+// Default wrapping for columns is WrapDefault
+func (t *Table) SetWrapping(columns ...Wrapping) {
+	t.wrapping = columns
+}
+
+func (t *Table) getWrapping(colIndex int) Wrapping {
+	if column := t.namedColumn(colIndex); column != nil && column.wrapping != nil {
+		return *column.wrapping
+	}
+	if colIndex >= len(t.wrapping) {
+		return WrapDefault
+	}
+	return t.wrapping[colIndex]
+}
+
+// SetTabWidth sets the distance between tab stops used to expand tabs in columns using WrapPreformatted. Defaults to 8.
+func (t *Table) SetTabWidth(width int) {
+	if width < 1 {
+		width = 1
+	}
+	t.tabWidth = width
+}
+
+// cellContent prepares a cell value in the given column for rendering
+func (t *Table) cellContent(value string, column int) string {
+	if t.getWrapping(column)&WrapPreformatted != 0 {
+		value = expandTabs(value, t.tabWidth)
+	}
+	return sanitizeContent(value, t.sanitize)
+}